@@ -0,0 +1,15 @@
+# Clusters whose name starts with "prod-" and that are not tagged environment=staging
+data "pf9_clusters" "production" {
+  filters = [
+    {
+      name     = "name"
+      values   = ["prod-"]
+      operator = "prefix"
+    },
+    {
+      name     = "tags:environment"
+      values   = ["staging"]
+      operator = "not"
+    }
+  ]
+}
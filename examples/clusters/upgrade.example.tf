@@ -0,0 +1,23 @@
+# Clusters that can be upgraded, with their current versions and sizes
+data "pf9_clusters" "upgradable" {
+  filters = [
+    {
+      name   = "can_upgrade"
+      values = ["true"]
+    },
+    {
+      name   = "status"
+      values = ["ok"]
+    }
+  ]
+}
+
+output "upgrade_wave" {
+  value = {
+    for cluster in data.pf9_clusters.upgradable.clusters : cluster.name => {
+      kube_role_version = cluster.kube_role_version
+      # the node counts are null for the clusters of other tenants
+      nodes = coalesce(cluster.master_nodes_count, 0) + coalesce(cluster.worker_nodes_count, 0)
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "pf9_addon_versions" "example" {
+  cluster_id = "2c5f75a1-5fb3-4d18-b9df-b6313d483961"
+}
+
+# Default version of every supported addon
+output "default_versions" {
+  value = { for addon in data.pf9_addon_versions.example.addons : addon.name => addon.default_version }
+}
@@ -0,0 +1,19 @@
+data "pf9_cluster_nodes" "example" {
+  cluster_id = "cc5b2a5e-1b4b-4b7e-8b3e-0e6b6b5e7b8a"
+}
+
+# Names of the nodes that are not Ready in Kubernetes
+output "not_ready_nodes" {
+  value = [for node in data.pf9_cluster_nodes.example.nodes : node.name if node.ready != true]
+}
+
+# Fail the plan if any node is not ready or under memory or disk pressure
+check "cluster_nodes_healthy" {
+  assert {
+    condition = alltrue([
+      for node in data.pf9_cluster_nodes.example.nodes :
+      node.ready == true && node.memory_pressure == false && node.disk_pressure == false
+    ])
+    error_message = "All the nodes of the cluster must be ready and have no memory or disk pressure"
+  }
+}
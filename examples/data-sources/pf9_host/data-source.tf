@@ -13,4 +13,9 @@ output "ens3_ip" {
     "ens3",
     ""
   )
+}
+
+# Total memory of the host in GiB, null if the hostagent did not report it
+output "memory_gib" {
+  value = try(data.pf9_host.example.memory.total_bytes / 1073741824, null)
 }
\ No newline at end of file
@@ -10,4 +10,12 @@ data "pf9_hosts" "connected" {
       regexes = ["Ubuntu.*"]
     }
   ]
-}
\ No newline at end of file
+}
+# Hostnames and interfaces of the matching hosts, without a pf9_host lookup per host
+output "hostnames" {
+  value = { for host in data.pf9_hosts.connected.hosts : host.id => host.hostname }
+}
+
+output "interfaces" {
+  value = { for host in data.pf9_hosts.connected.hosts : host.hostname => host.interfaces }
+}
@@ -0,0 +1,16 @@
+data "pf9_kube_role_versions" "example" {
+  cluster_id = "2c5f75a1-5fb3-4d18-b9df-b6313d483961"
+}
+
+output "latest" {
+  value = data.pf9_kube_role_versions.example.latest
+}
+
+# The version the cluster can be upgraded to, preferring the minor upgrade
+output "upgrade_target" {
+  value = coalesce(
+    data.pf9_kube_role_versions.example.minor_upgrade_role_version,
+    data.pf9_kube_role_versions.example.patch_upgrade_role_version,
+    "none"
+  )
+}
@@ -0,0 +1,11 @@
+# Only the non-secret attributes are stored in the state, the credentials
+# are provided by other means, such as an exec plugin or a client certificate
+# kept outside of Terraform
+data "pf9_kubeconfig" "example" {
+  id              = pf9_cluster.example.id
+  exclude_secrets = true
+}
+
+output "api_server" {
+  value = data.pf9_kubeconfig.example.kubeconfigs[0].host
+}
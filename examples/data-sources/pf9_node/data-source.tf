@@ -2,3 +2,8 @@ data "pf9_node" "node1" {
   # Provides access to the attributes of the node, name, cluster_name, status, etc.
   id = "dfad3588-aba1-4e46-b2db-673c69faf63d"
 }
+
+data "pf9_node" "node2" {
+  # Looks up the node by its IP address instead of the ID
+  primary_ip = "10.0.0.12"
+}
@@ -1,11 +1,24 @@
 data "pf9_nodepools" "example" {
+  # Filters are allowed on the following attributes:
+  # id, name, cloud_provider_name, cloud_provider_uuid, node_ids, cluster_ids
   # Finds all the nodepools with the name "defaultPool"
-  filter = {
-    name   = "name"
-    values = ["defaultPool"]
-  }
+  filters = [
+    {
+      name   = "name"
+      values = ["defaultPool"]
+    }
+  ]
 }
 
 output "defaultnodepoolid" {
   value = data.pf9_nodepools.example.nodepools[0].id
-}
\ No newline at end of file
+}
+
+# Nodes of the nodepool and the clusters they are attached to
+output "defaultnodepool_node_ids" {
+  value = data.pf9_nodepools.example.nodepools[0].node_ids
+}
+
+output "defaultnodepool_cluster_ids" {
+  value = data.pf9_nodepools.example.nodepools[0].cluster_ids
+}
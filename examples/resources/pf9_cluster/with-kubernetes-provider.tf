@@ -0,0 +1,28 @@
+resource "pf9_cluster" "example" {
+  name              = "mycluster"
+  master_nodes      = ["2c5f75a1-5fb3-4d18-b9df-b6313d483961"]
+  master_vip_ipv4   = "10.149.107.240"
+  master_vip_iface  = "ens3"
+  external_dns_name = "mycluster.example.com"
+}
+
+# The credentials are read from the kubeconfig of the cluster. Referring to the
+# resource defers the read until the cluster exists.
+data "pf9_kubeconfig" "example" {
+  id                    = pf9_cluster.example.id
+  authentication_method = "token"
+}
+
+provider "kubernetes" {
+  host                   = pf9_cluster.example.api_endpoint
+  cluster_ca_certificate = base64decode(pf9_cluster.example.cluster_ca_certificate)
+  token                  = data.pf9_kubeconfig.example.kubeconfigs[0].token
+}
+
+provider "helm" {
+  kubernetes {
+    host                   = pf9_cluster.example.api_endpoint
+    cluster_ca_certificate = base64decode(pf9_cluster.example.cluster_ca_certificate)
+    token                  = data.pf9_kubeconfig.example.kubeconfigs[0].token
+  }
+}
@@ -0,0 +1,2 @@
+# Use <cluster_id>/<type> to import an addon into terraform
+terraform import pf9_cluster_addon.metallb d7727229-b2b4-4725-b0a0-473208f5093a/metallb
@@ -0,0 +1,16 @@
+resource "pf9_cluster_addon" "metallb" {
+  cluster_id = pf9_cluster.example.id
+  type       = "metallb"
+  params = {
+    MetallbIpRange = "192.168.5.0-192.168.6.0"
+  }
+}
+
+resource "pf9_cluster_addon" "monitoring" {
+  cluster_id = pf9_cluster.example.id
+  type       = "monitoring"
+  version    = "0.67.0"
+  params = {
+    retentionTime = "7d"
+  }
+}
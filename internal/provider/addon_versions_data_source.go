@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/platform9/pf9-sdk-go/pf9/pmk"
+	"github.com/platform9/terraform-provider-pf9/internal/provider/datasource_addon_versions"
+)
+
+var _ datasource.DataSource = (*addonVersionsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*addonVersionsDataSource)(nil)
+
+func NewAddonVersionsDataSource() datasource.DataSource {
+	return &addonVersionsDataSource{}
+}
+
+type addonVersionsDataSource struct {
+	client   *pmk.HTTPClient
+	qbertAPI *qbertAPIClient
+}
+
+func (d *addonVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addon_versions"
+}
+
+func (d *addonVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_addon_versions.AddonVersionsDataSourceSchema(ctx)
+}
+
+func (d *addonVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData := req.ProviderData.(*dataSourceProviderData)
+	d.client = providerData.client
+	d.qbertAPI = providerData.qbertAPI
+}
+
+func (d *addonVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_addon_versions.AddonVersionsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterId.ValueString()
+	if data.ClusterId.IsNull() {
+		// The supported addons depend on the kube role version, qbert reports them only
+		// for a cluster so read them from a cluster of the project running the same version
+		kubeRoleVersion := data.KubeRoleVersion.ValueString()
+		var err error
+		clusterID, err = findProjectCluster(ctx, d.client, kubeRoleVersion)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list clusters", err.Error())
+			return
+		}
+		if clusterID == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("kube_role_version"), "No cluster found",
+				fmt.Sprintf("The supported addons are read from a cluster, but no cluster of the project runs the kube role version %v", kubeRoleVersion))
+			return
+		}
+	}
+
+	catalog, err := getAddonCatalog(ctx, d.client, d.qbertAPI, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get supported addon versions", err.Error())
+		return
+	}
+	addons := []datasource_addon_versions.AddonsValue{}
+	for _, addonName := range catalog.Names() {
+		versions, diags := types.ListValueFrom(ctx, types.StringType, catalog.Versions(addonName))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		addon, diags := datasource_addon_versions.NewAddonsValue(datasource_addon_versions.AddonsValue{}.AttributeTypes(ctx),
+			map[string]attr.Value{
+				"name":            types.StringValue(addonName),
+				"default_version": getStrOrNullIfEmpty(catalog.DefaultVersion(addonName)),
+				"versions":        versions,
+			})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		addons = append(addons, addon)
+	}
+	addonsValue, diags := types.ListValueFrom(ctx, datasource_addon_versions.AddonsValue{}.Type(ctx), addons)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Addons = addonsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,470 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/platform9/pf9-sdk-go/pf9/pmk"
+	"github.com/platform9/terraform-provider-pf9/internal/provider/resource_cluster_addon"
+
+	sunpikev1alpha2 "github.com/platform9/pf9-sdk-go/pf9/apis/sunpike/v1alpha2"
+)
+
+var _ resource.Resource = (*clusterAddonResource)(nil)
+var _ resource.ResourceWithConfigure = (*clusterAddonResource)(nil)
+var _ resource.ResourceWithImportState = (*clusterAddonResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*clusterAddonResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*clusterAddonResource)(nil)
+
+func NewClusterAddonResource() resource.Resource {
+	return &clusterAddonResource{}
+}
+
+type clusterAddonResource struct {
+	client       *pmk.HTTPClient
+	qbertAPI     *qbertAPIClient
+	addonsClient AddonsClient
+}
+
+func (r *clusterAddonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_addon"
+}
+
+func (r *clusterAddonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_cluster_addon.ClusterAddonResourceSchema(ctx)
+}
+
+func (r *clusterAddonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData := req.ProviderData.(*resourceProviderData)
+	r.client = providerData.client
+	r.qbertAPI = providerData.qbertAPI
+	r.addonsClient = NewAddonClient(r.client.Sunpike(), providerData.addonsConfig)
+}
+
+func (r *clusterAddonResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_cluster_addon.ClusterAddonModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ignoreParams, diags := getIgnoreParams(ctx, data.IgnoreParams)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// only the keys are compared, values may be unknown during validation
+	params := data.Params.Elements()
+	sensitiveParams := data.SensitiveParams.Elements()
+	for _, key := range ignoreParams {
+		if _, ok := params[key]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("ignore_params"), "Conflicting addon params",
+				fmt.Sprintf("%v is ignored, it cannot be set in params", key))
+		}
+		if _, ok := sensitiveParams[key]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("sensitive_params"), "Conflicting addon params",
+				fmt.Sprintf("%v is a sensitive param, it cannot be set in ignore_params", key))
+		}
+	}
+	for key := range sensitiveParams {
+		if _, ok := params[key]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("sensitive_params"), "Conflicting addon params",
+				fmt.Sprintf("%v is a sensitive param, it cannot be set in params", key))
+		}
+	}
+}
+
+func (r *clusterAddonResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// resource is being destroyed
+		return
+	}
+	var data resource_cluster_addon.ClusterAddonModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.SensitiveParams.IsUnknown() {
+		// plan the hashes, so that a change of a sensitive param shows up as a change of its hash
+		sensitiveParamsHash := types.MapNull(types.StringType)
+		if !data.SensitiveParams.IsNull() {
+			sensitiveParams, diags := getSensitiveParams(ctx, data.SensitiveParams)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			priorHashes := map[string]string{}
+			if !req.State.Raw.IsNull() {
+				var stateSensitiveParamsHash types.Map
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("sensitive_params_hash"), &stateSensitiveParamsHash)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if !stateSensitiveParamsHash.IsNull() {
+					resp.Diagnostics.Append(stateSensitiveParamsHash.ElementsAs(ctx, &priorHashes, false)...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+				}
+			}
+			hashes, err := hashSensitiveParams(sensitiveParams, priorHashes)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("sensitive_params"), "Failed to hash the sensitive params", err.Error())
+				return
+			}
+			sensitiveParamsHash, diags = types.MapValueFrom(ctx, types.StringType, hashes)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sensitive_params_hash"), sensitiveParamsHash)...)
+	}
+	isCreate := req.State.Raw.IsNull()
+	hasConstraint := !data.VersionConstraint.IsNull() && !data.VersionConstraint.IsUnknown()
+	if data.ClusterId.IsUnknown() || data.Type.IsUnknown() || (!isCreate && !hasConstraint) {
+		// An existing addon needs the catalog only to resolve the version constraint
+		return
+	}
+	catalog, err := getAddonCatalog(ctx, r.client, r.qbertAPI, data.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get supported addon versions", err.Error())
+		return
+	}
+	addonType := data.Type.ValueString()
+	if isCreate && !catalog.Contains(addonType) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Unknown addon",
+			unknownAddonError(addonType, "the cluster "+data.ClusterId.ValueString(), catalog))
+		return
+	}
+	if hasConstraint {
+		var installedVersion types.String
+		if !isCreate {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("version"), &installedVersion)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		version, err := catalog.ResolveVersion(addonType, data.VersionConstraint.ValueString(), installedVersion.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version_constraint"), "Failed to resolve addon version", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), version)...)
+		return
+	}
+	if data.Version.IsUnknown() && catalog.DefaultVersion(addonType) != "" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), catalog.DefaultVersion(addonType))...)
+	}
+}
+
+func (r *clusterAddonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_cluster_addon.ClusterAddonModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authInfo, err := r.client.Authenticator().Auth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to authenticate", err.Error())
+		return
+	}
+	clusterID := data.ClusterId.ValueString()
+	addonType := data.Type.ValueString()
+	_, err = r.client.Qbert().GetCluster(ctx, authInfo.ProjectID, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_id"), "Failed to get cluster", err.Error())
+		return
+	}
+	existingAddons, err := r.listActiveAddons(ctx, clusterID, addonType)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list addons", err.Error())
+		return
+	}
+	if len(existingAddons) > 0 {
+		resp.Diagnostics.AddError("Addon is already enabled",
+			fmt.Sprintf("The addon %v is already enabled on the cluster %v. Import it using the ID %v/%v", addonType, clusterID, clusterID, addonType))
+		return
+	}
+
+	addonSpec := AddonSpec{
+		ClusterID: clusterID,
+		Type:      addonType,
+	}
+	addonSpec.Timeout, err = parseAddonTimeout(data.Timeouts.Create)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("create"), "Invalid timeout", err.Error())
+		return
+	}
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
+		addonSpec.Version = data.Version.ValueString()
+	} else {
+		catalog, err := getAddonCatalog(ctx, r.client, r.qbertAPI, clusterID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get supported addon versions", err.Error())
+			return
+		}
+		addonSpec.Version = catalog.DefaultVersion(addonType)
+		if !data.VersionConstraint.IsNull() && !data.VersionConstraint.IsUnknown() {
+			// cluster_id was not known at plan time, resolve the constraint now
+			addonSpec.Version, err = catalog.ResolveVersion(addonType, data.VersionConstraint.ValueString(), "")
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("version_constraint"), "Failed to resolve addon version", err.Error())
+				return
+			}
+		}
+		if addonSpec.Version == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Default version not found",
+				fmt.Sprintf("Could not find the default version of the addon %v, provide the version explicitly", addonType))
+			return
+		}
+	}
+	paramsMap, diags := getAddonParamsMap(ctx, data.Params)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sensitiveParams, diags := getSensitiveParams(ctx, data.SensitiveParams)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSensitiveParams(ctx, sensitiveParams)
+	addonSpec.ParamsMap = mergeParams(paramsMap, sensitiveParams)
+	addonSpec.SensitiveParams = mapKeys(sensitiveParams)
+
+	tflog.Info(ctx, "Enabling addon", map[string]interface{}{"clusterID": clusterID, "addon": addonType, "version": addonSpec.Version})
+	err = r.addonsClient.Enable(ctx, addonSpec)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to enable addon", err.Error())
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", clusterID, addonType))
+	// Save the ID so that a failed installation is tracked and can be destroyed
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), data.ClusterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), data.Type)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sunpikeAddon, err := r.addonsClient.WaitForInstall(ctx, addonSpec)
+	if err != nil {
+		resp.Diagnostics.AddError("Addon installation did not complete", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(sunpikeAddonToTerraformClusterAddon(ctx, sunpikeAddon, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *clusterAddonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_cluster_addon.ClusterAddonModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Authenticator().Auth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to authenticate", err.Error())
+		return
+	}
+	clusterID := data.ClusterId.ValueString()
+	addonType := data.Type.ValueString()
+	sunpikeAddons, err := r.listActiveAddons(ctx, clusterID, addonType)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list addons", err.Error())
+		return
+	}
+	if len(sunpikeAddons) == 0 {
+		tflog.Info(ctx, "Addon not found, removing it from the state", map[string]interface{}{"clusterID": clusterID, "addon": addonType})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(sunpikeAddonToTerraformClusterAddon(ctx, sunpikeAddons[0], &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *clusterAddonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resource_cluster_addon.ClusterAddonModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Authenticator().Auth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to authenticate", err.Error())
+		return
+	}
+	clusterID := state.ClusterId.ValueString()
+	addonType := state.Type.ValueString()
+	sunpikeAddons, err := r.listActiveAddons(ctx, clusterID, addonType)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list addons", err.Error())
+		return
+	}
+	if len(sunpikeAddons) == 0 {
+		resp.Diagnostics.AddError("Addon not found",
+			fmt.Sprintf("The addon %v is not enabled on the cluster %v, refresh the state and apply again", addonType, clusterID))
+		return
+	}
+	addonSpec := AddonSpec{
+		ClusterID: clusterID,
+		Type:      addonType,
+		Version:   plan.Version.ValueString(),
+	}
+	if plan.Version.IsUnknown() {
+		addonSpec.Version = sunpikeAddons[0].Spec.Version
+	}
+	addonSpec.Timeout, err = parseAddonTimeout(plan.Timeouts.Update)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("update"), "Invalid timeout", err.Error())
+		return
+	}
+	paramsMap, diags := getAddonParamsMap(ctx, plan.Params)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sensitiveParams, diags := getSensitiveParams(ctx, plan.SensitiveParams)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSensitiveParams(ctx, sensitiveParams)
+	addonSpec.ParamsMap = mergeParams(paramsMap, sensitiveParams)
+	addonSpec.SensitiveParams = mapKeys(sensitiveParams)
+	addonSpec.IgnoreParams, diags = getIgnoreParams(ctx, plan.IgnoreParams)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Patching addon", map[string]interface{}{"clusterID": clusterID, "addon": addonType, "version": addonSpec.Version})
+	err = r.addonsClient.Patch(ctx, addonSpec, &sunpikeAddons[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to patch addon", err.Error())
+		return
+	}
+	sunpikeAddon, err := r.addonsClient.WaitForInstall(ctx, addonSpec)
+	if err != nil {
+		resp.Diagnostics.AddError("Addon installation did not complete", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(sunpikeAddonToTerraformClusterAddon(ctx, sunpikeAddon, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterAddonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_cluster_addon.ClusterAddonModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Authenticator().Auth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to authenticate", err.Error())
+		return
+	}
+	timeout, err := parseAddonTimeout(data.Timeouts.Delete)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("delete"), "Invalid timeout", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Disabling addon", map[string]interface{}{"clusterID": data.ClusterId.ValueString(), "addon": data.Type.ValueString()})
+	err = r.addonsClient.Disable(ctx, AddonSpec{
+		ClusterID: data.ClusterId.ValueString(),
+		Type:      data.Type.ValueString(),
+		Timeout:   timeout,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to disable addon", err.Error())
+		return
+	}
+}
+
+func (r *clusterAddonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: <cluster_id>/<type>
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <cluster_id>/<type>, got: %v", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), idParts[1])...)
+}
+
+// listActiveAddons lists the addons of the given type on the cluster, skipping the ones being deleted
+func (r *clusterAddonResource) listActiveAddons(ctx context.Context, clusterID string, addonType string) ([]sunpikev1alpha2.ClusterAddon, error) {
+	sunpikeAddons, err := r.addonsClient.List(ctx, clusterID, addonType)
+	if err != nil {
+		return nil, err
+	}
+	return getActiveAddons(sunpikeAddons), nil
+}
+
+func getAddonParamsMap(ctx context.Context, params types.Map) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	paramsMap := map[string]string{}
+	if params.IsNull() || params.IsUnknown() {
+		return paramsMap, diags
+	}
+	diags = params.ElementsAs(ctx, &paramsMap, false)
+	return paramsMap, diags
+}
+
+func sunpikeAddonToTerraformClusterAddon(ctx context.Context, sunpikeAddon sunpikev1alpha2.ClusterAddon, data *resource_cluster_addon.ClusterAddonModel) diag.Diagnostics {
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.ClusterId.ValueString(), sunpikeAddon.Spec.Type))
+	data.Type = types.StringValue(sunpikeAddon.Spec.Type)
+	data.Version = types.StringValue(sunpikeAddon.Spec.Version)
+	data.Phase = types.StringValue(string(sunpikeAddon.Status.Phase))
+	ignoreParams, diags := getIgnoreParams(ctx, data.IgnoreParams)
+	if diags.HasError() {
+		return diags
+	}
+	remoteParams := convertParamsToMap(sunpikeAddon.Spec.Override.Params)
+	sensitiveParamKeys := data.SensitiveParams.Elements()
+	data.SensitiveParams, data.SensitiveParamsHash, diags = sunpikeSensitiveParamsToTerraform(ctx, data.SensitiveParams,
+		data.SensitiveParamsHash, remoteParams)
+	if diags.HasError() {
+		return diags
+	}
+	// ignored params are managed outside of Terraform and sensitive params are tracked by
+	// sensitive_params, keep them out of params
+	paramsMap := withoutParams(remoteParams, ignoreParams)
+	for key := range sensitiveParamKeys {
+		delete(paramsMap, key)
+	}
+	if len(paramsMap) == 0 && (data.Params.IsNull() || data.Params.IsUnknown()) {
+		// keep params null in the state when the user did not provide them
+		data.Params = types.MapNull(types.StringType)
+		return diags
+	}
+	data.Params, diags = types.MapValueFrom(ctx, types.StringType, paramsMap)
+	return diags
+}
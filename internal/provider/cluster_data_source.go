@@ -39,7 +39,7 @@ func (d *clusterDataSource) Configure(ctx context.Context, req datasource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -61,6 +61,28 @@ func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	projectID := authInfo.ProjectID
+	if !data.Name.IsNull() {
+		clusters, err := d.client.Qbert().ListClusters(qbert.ListOptions{
+			All: true,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list clusters", err.Error())
+			return
+		}
+		// The cluster is read from the current tenant, names are unique only within a tenant
+		clusters, err = applyFilters(clusters, []Filter{{Name: "tenant_id", Values: []string{projectID}}}, clusterFilterAttributes(nil))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to filter clusters", err.Error())
+			return
+		}
+		cluster, diags := lookupOne(clusters, clusterFilterAttributes(nil), "cluster", "name", data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clusterID = cluster.UUID
+		data.Id = types.StringValue(clusterID)
+	}
 	tflog.Info(ctx, "Reading cluster", map[string]interface{}{"clusterID": clusterID})
 	cluster, err := d.client.Qbert().GetCluster(ctx, projectID, clusterID)
 	if err != nil {
@@ -73,6 +95,18 @@ func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// The API server attributes are null if the kubeconfig is not available yet, e.g. while
+	// the cluster is being created, the qbert attributes are still returned
+	apiServer, err := getClusterAPIServer(ctx, d.client, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Failed to read API server of the cluster",
+			fmt.Sprintf("api_endpoint and cluster_ca_certificate are null: %v", err))
+		data.ApiEndpoint = types.StringNull()
+		data.ClusterCaCertificate = types.StringNull()
+	} else {
+		data.ApiEndpoint = types.StringValue(getClusterAPIEndpoint(cluster, apiServer))
+		data.ClusterCaCertificate = getStrOrNullIfEmpty(apiServer.Cluster.CertificateAuthorityData)
+	}
 	tflog.Info(ctx, "Listing nodes attached to the cluster", map[string]interface{}{"clusterID": clusterID})
 	clusterNodes, err := d.client.Qbert().ListClusterNodes(ctx, clusterID)
 	if err != nil {
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/platform9/pf9-sdk-go/pf9/pmk"
+	"github.com/platform9/pf9-sdk-go/pf9/qbert"
+	"github.com/platform9/terraform-provider-pf9/internal/provider/datasource_cluster_nodes"
+)
+
+var _ datasource.DataSource = (*clusterNodesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*clusterNodesDataSource)(nil)
+
+func NewClusterNodesDataSource() datasource.DataSource {
+	return &clusterNodesDataSource{}
+}
+
+type clusterNodesDataSource struct {
+	client *pmk.HTTPClient
+}
+
+func (d *clusterNodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_nodes"
+}
+
+func (d *clusterNodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_cluster_nodes.ClusterNodesDataSourceSchema(ctx)
+}
+
+func (d *clusterNodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*dataSourceProviderData).client
+}
+
+func (d *clusterNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_cluster_nodes.ClusterNodesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterId.ValueString()
+	tflog.Info(ctx, "Listing nodes attached to the cluster", map[string]interface{}{"clusterID": clusterID})
+	qbertNodes, err := d.client.Qbert().ListClusterNodes(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get cluster nodes", err.Error())
+		return
+	}
+	sort.Slice(qbertNodes, func(i, j int) bool { return qbertNodes[i].Name < qbertNodes[j].Name })
+
+	// The Kubernetes attributes are null if the API of the cluster is not reachable, e.g. while
+	// the cluster is being created, the qbert attributes are still returned
+	kubeNodeList := corev1.NodeList{}
+	kubeClient, err := newClusterKubeClient(ctx, d.client, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Failed to create Kubernetes client",
+			fmt.Sprintf("The Kubernetes attributes of the nodes are null: %v", err))
+	} else if err := kubeClient.List(ctx, &kubeNodeList); err != nil {
+		resp.Diagnostics.AddWarning("Failed to list Kubernetes nodes",
+			fmt.Sprintf("The Kubernetes attributes of the nodes are null: %v", err))
+	}
+	// qbert registers a node in Kubernetes by its IP or host name
+	kubeNodes := map[string]corev1.Node{}
+	for _, kubeNode := range kubeNodeList.Items {
+		kubeNodes[kubeNode.Name] = kubeNode
+		for _, address := range kubeNode.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				kubeNodes[address.Address] = kubeNode
+			}
+		}
+	}
+
+	nodesValues := make([]datasource_cluster_nodes.NodesValue, len(qbertNodes))
+	for i, qbertNode := range qbertNodes {
+		kubeNode, found := kubeNodes[qbertNode.PrimaryIP]
+		if !found {
+			kubeNode, found = kubeNodes[qbertNode.Name]
+		}
+		if !found {
+			tflog.Warn(ctx, "Node is not registered in Kubernetes", map[string]interface{}{"nodeID": qbertNode.UUID})
+		}
+		nodesValue, diags := clusterNodeToNodesValue(ctx, qbertNode, kubeNode, found)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		nodesValues[i] = nodesValue
+	}
+	nodesListVal, diags := types.ListValueFrom(ctx, datasource_cluster_nodes.NodesValue{}.Type(ctx), nodesValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Nodes = nodesListVal
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// clusterNodeToNodesValue combines the qbert node with its Kubernetes node, the Kubernetes
+// attributes are null if the node is not registered in Kubernetes
+func clusterNodeToNodesValue(ctx context.Context, qbertNode qbert.Node, kubeNode corev1.Node, registered bool) (datasource_cluster_nodes.NodesValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attributes := map[string]attr.Value{
+		"id":                       types.StringValue(qbertNode.UUID),
+		"name":                     types.StringValue(qbertNode.Name),
+		"primary_ip":               types.StringValue(qbertNode.PrimaryIP),
+		"is_master":                types.BoolValue(qbertNode.IsMaster == 1),
+		"status":                   types.StringValue(qbertNode.Status),
+		"api_responding":           types.BoolValue(qbertNode.APIResponding == 1),
+		"actual_kube_role_version": getStrOrNullIfEmpty(qbertNode.ActualKubeRoleVersion),
+		"node_pool_uuid":           getStrOrNullIfEmpty(qbertNode.NodePoolUUID),
+		"node_pool_name":           getStrOrNullIfEmpty(qbertNode.NodePoolName),
+		"kubernetes_node_name":     types.StringNull(),
+		"ready":                    types.BoolNull(),
+		"memory_pressure":          types.BoolNull(),
+		"disk_pressure":            types.BoolNull(),
+		"kubelet_version":          types.StringNull(),
+		"labels":                   types.MapNull(types.StringType),
+		"taints":                   types.ListNull(datasource_cluster_nodes.TaintsValue{}.Type(ctx)),
+	}
+	if registered {
+		attributes["kubernetes_node_name"] = types.StringValue(kubeNode.Name)
+		attributes["ready"] = getKubeNodeCondition(kubeNode, corev1.NodeReady)
+		attributes["memory_pressure"] = getKubeNodeCondition(kubeNode, corev1.NodeMemoryPressure)
+		attributes["disk_pressure"] = getKubeNodeCondition(kubeNode, corev1.NodeDiskPressure)
+		attributes["kubelet_version"] = getStrOrNullIfEmpty(kubeNode.Status.NodeInfo.KubeletVersion)
+		labels, convertDiags := types.MapValueFrom(ctx, types.StringType, kubeNode.Labels)
+		diags.Append(convertDiags...)
+		attributes["labels"] = labels
+		taints := []datasource_cluster_nodes.TaintsValue{}
+		for _, taint := range kubeNode.Spec.Taints {
+			taintValue, convertDiags := datasource_cluster_nodes.NewTaintsValue(datasource_cluster_nodes.TaintsValue{}.AttributeTypes(ctx),
+				map[string]attr.Value{
+					"key":    types.StringValue(taint.Key),
+					"value":  getStrOrNullIfEmpty(taint.Value),
+					"effect": types.StringValue(string(taint.Effect)),
+				})
+			diags.Append(convertDiags...)
+			taints = append(taints, taintValue)
+		}
+		taintsValue, convertDiags := types.ListValueFrom(ctx, datasource_cluster_nodes.TaintsValue{}.Type(ctx), taints)
+		diags.Append(convertDiags...)
+		attributes["taints"] = taintsValue
+		if diags.HasError() {
+			return datasource_cluster_nodes.NodesValue{}, diags
+		}
+	}
+	nodesValue, convertDiags := datasource_cluster_nodes.NewNodesValue(datasource_cluster_nodes.NodesValue{}.AttributeTypes(ctx), attributes)
+	diags.Append(convertDiags...)
+	return nodesValue, diags
+}
+
+// getKubeNodeCondition returns the status of the condition, null if it is unknown or not reported
+func getKubeNodeCondition(kubeNode corev1.Node, conditionType corev1.NodeConditionType) types.Bool {
+	for _, condition := range kubeNode.Status.Conditions {
+		if condition.Type != conditionType {
+			continue
+		}
+		switch condition.Status {
+		case corev1.ConditionTrue:
+			return types.BoolValue(true)
+		case corev1.ConditionFalse:
+			return types.BoolValue(false)
+		}
+	}
+	return types.BoolNull()
+}
+
+// newClusterKubeClient returns a client of the Kubernetes API of the cluster, authenticated
+// with the keystone token of the provider
+func newClusterKubeClient(ctx context.Context, pmkClient *pmk.HTTPClient, clusterID string) (client.Client, error) {
+	authInfo, err := pmkClient.Authenticator().Auth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	}
+	kubeconfigBlob, err := pmkClient.Qbert().GetClusterKubeconfig(authInfo.ProjectID, clusterID, authInfo.Token, qbert.KubeconfigOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster kubeconfig: %w", err)
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfigBlob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cluster kubeconfig: %w", err)
+	}
+	return client.New(restConfig, client.Options{})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 
@@ -12,9 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/platform9/pf9-sdk-go/pf9/pmk"
 	"github.com/platform9/pf9-sdk-go/pf9/qbert"
+	"github.com/platform9/pf9-sdk-go/pf9/resmgr"
 	"github.com/platform9/terraform-provider-pf9/internal/provider/resource_cluster"
 
 	sunpikev1alpha2 "github.com/platform9/pf9-sdk-go/pf9/apis/sunpike/v1alpha2"
@@ -32,7 +35,10 @@ func NewClusterResource() resource.Resource {
 
 type clusterResource struct {
 	client       *pmk.HTTPClient
+	qbertAPI     *qbertAPIClient
 	addonsClient AddonsClient
+	// addonConcurrency is the number of addon actions applied at the same time
+	addonConcurrency int
 }
 
 func (r *clusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,8 +53,11 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	r.client = req.ProviderData.(*pmk.HTTPClient)
-	r.addonsClient = NewAddonClient(r.client.Sunpike())
+	providerData := req.ProviderData.(*resourceProviderData)
+	r.client = providerData.client
+	r.qbertAPI = providerData.qbertAPI
+	r.addonsClient = NewAddonClient(r.client.Sunpike(), providerData.addonsConfig)
+	r.addonConcurrency = providerData.addonConcurrency
 }
 
 func (c clusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -105,6 +114,28 @@ func (c clusterResource) ValidateConfig(ctx context.Context, req resource.Valida
 			resp.Diagnostics.AddAttributeError(path.Root("containers_cidr"), "CIDRs overlap", "containers_cidr and services_cidr cannot overlap")
 		}
 	}
+
+	if !data.Addons.IsNull() && !data.Addons.IsUnknown() {
+		tfAddonsMap := map[string]resource_cluster.AddonsValue{}
+		resp.Diagnostics.Append(data.Addons.ElementsAs(ctx, &tfAddonsMap, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for addonName, tfAddon := range tfAddonsMap {
+			if tfAddon.IsNull() || tfAddon.IsUnknown() {
+				continue
+			}
+			resp.Diagnostics.Append(validateTypedAddonBlocks(ctx, addonName, tfAddon)...)
+			resp.Diagnostics.Append(validateIgnoredAddonParams(ctx, addonName, tfAddon)...)
+			resp.Diagnostics.Append(validateSensitiveAddonParams(ctx, addonName, tfAddon)...)
+			if !tfAddon.VersionConstraint.IsNull() && !tfAddon.VersionConstraint.IsUnknown() {
+				if _, err := parseAddonVersionConstraint(tfAddon.VersionConstraint.ValueString()); err != nil {
+					resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(addonName).AtName("version_constraint"),
+						"Invalid version constraint", err.Error())
+				}
+			}
+		}
+	}
 }
 
 func (r clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -195,6 +226,29 @@ func (r clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 			}
 		}
 	}
+	var planData, stateData resource_cluster.ClusterModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(r.verifyPlannedNodesForAttach(ctx, authInfo.ProjectID, planData, stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.modifyPlanAddons(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	modifyPlanSensitiveParamsHash(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
 		// Pre-Update
 		var stateKubeRoleVersion types.String
@@ -278,9 +332,8 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Failed to get qbert nodes", err.Error())
 		return
 	}
-	err = r.verifyNodesForAttach(ctx, nodesToAttachIDs, qbertNodesMap)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to verify nodes", err.Error())
+	resp.Diagnostics.Append(r.verifyNodesForAttach(ctx, nodesToAttachIDs, qbertNodesMap, data.ContainersCidr.ValueString(), data.ServicesCidr.ValueString())...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 	createClusterReq, diags := createCreateClusterRequest(ctx, &data)
@@ -344,170 +397,18 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	state.MasterNodes = masterNodesSetVal
 
 	if !data.Addons.IsNull() && !data.Addons.IsUnknown() {
-		// This is a workaround because default addons are not being set in the plan.
-		// Since we cannot set the default addon parameters in the plan due to this issue,
-		// we are instead not enabling any addons in the plan. This ensures that the user's
-		// intent is respected, even though the backend may still enable the default addons.
-		//
-		// The "computed_optional" attribute on the "addons" field allows Terraform to
-		// successfully apply the plan without raising an error due to the mismatch between
-		// the plan and the remote state.
-		//
-		// This workaround is necessary until the underlying issue with the addon parameters
-		// being lost in the `Create()` function is resolved.
-
-		// TODO: Refactor this code to a separate function
-		tflog.Debug(ctx, "Getting list of enabled addons")
-		defaultEnabledAddons, err := r.listClusterAddons(ctx, clusterID)
+		// The plan has the default addons from ModifyPlan if addons is omitted, reconcile them with
+		// the addons enabled by the backend on cluster creation, the unlisted ones are disabled
+		timeout, err := parseAddonTimeout(data.Timeouts.Create)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to get cluster addons", err.Error())
+			resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("create"), "Invalid timeout", err.Error())
 			return
 		}
-
-		// Create a map key=addonName value=sunpikeAddon for lookup during plan-state comparison
-		sunpikeAddonsMap := map[string]sunpikev1alpha2.ClusterAddon{}
-		for _, sunpikeAddon := range defaultEnabledAddons {
-			sunpikeAddonsMap[sunpikeAddon.Spec.Type] = sunpikeAddon
-		}
-		var addonsFromPlan types.Map
-		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("addons"), &addonsFromPlan)...)
-		// resp.Diagnostics.Append(data.Addons.ElementsAs(ctx, &addonsFromPlan, false)...)
+		resp.Diagnostics.Append(r.reconcileClusterAddons(ctx, clusterID, data.Addons, timeout)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		if !addonsFromPlan.IsNull() && !addonsFromPlan.IsUnknown() {
-			tfAddonsMap := map[string]resource_cluster.AddonsValue{}
-			resp.Diagnostics.Append(addonsFromPlan.ElementsAs(ctx, &tfAddonsMap, false)...)
-			for addonName, tfAddon := range tfAddonsMap {
-				if tfAddon.IsNull() {
-					tflog.Debug(ctx, "Disabling addon because addon value is null", map[string]interface{}{"addon": addonName})
-					err = r.addonsClient.Disable(ctx, AddonSpec{ClusterID: clusterID, Type: addonName})
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to disable addon", err.Error())
-						return
-					}
-					continue
-				}
-				// sunpikeAddon represents remote state and tfAddon represents plan state
-				if sunpikeAddon, found := sunpikeAddonsMap[addonName]; found {
-					// Case 1:
-					// if addon with the same name is available at both places, difference bw
-					// the two should be patched, prefering the plan instance.
-					if !tfAddon.Enabled.IsNull() && !tfAddon.Enabled.IsUnknown() && !tfAddon.Enabled.ValueBool() {
-						tflog.Debug(ctx, "Disabling addon because enabled=false", map[string]interface{}{"addon": addonName})
-						err = r.addonsClient.Disable(ctx, AddonSpec{ClusterID: clusterID, Type: addonName})
-						if err != nil {
-							resp.Diagnostics.AddError("Failed to disable addon", err.Error())
-							return
-						}
-						continue
-					}
-					tflog.Debug(ctx, "Checking if addon version and params needs to be patched")
-					var addonVersion string
-					if !tfAddon.Version.IsNull() && !tfAddon.Version.IsUnknown() {
-						addonVersion = tfAddon.Version.ValueString()
-					} else {
-						// version is optional in the plan, because user cannot determine the version.
-						// If user does not provide the version, we will use the version that is already
-						// present in the remote state.
-						addonVersion = sunpikeAddon.Spec.Version
-					}
-					tfParamsInPlan := map[string]types.String{}
-					if !tfAddon.Params.IsUnknown() {
-						resp.Diagnostics.Append(tfAddon.Params.ElementsAs(ctx, &tfParamsInPlan, false)...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-					}
-					paramsInPlan := map[string]string{}
-					for key, value := range tfParamsInPlan {
-						paramsInPlan[key] = value.ValueString()
-					}
-					err := r.addonsClient.Patch(ctx, AddonSpec{
-						ClusterID: clusterID,
-						Type:      addonName,
-						Version:   addonVersion,
-						ParamsMap: paramsInPlan,
-					}, &sunpikeAddon)
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to patch addon", err.Error())
-						return
-					}
-				} else {
-					// Case 2.1:
-					// the addon is present in the plan but enabled=false
-					if !tfAddon.Enabled.IsNull() && !tfAddon.Enabled.IsUnknown() && !tfAddon.Enabled.ValueBool() {
-						tflog.Debug(ctx, "Disabling addon because enabled=false", map[string]interface{}{"addon": addonName})
-						err = r.addonsClient.Disable(ctx, AddonSpec{ClusterID: clusterID, Type: addonName})
-						if err != nil {
-							resp.Diagnostics.AddError("Failed to disable addon", err.Error())
-							return
-						}
-						continue
-					}
-					// Case 2.2:
-					// The addon in the plan, tfAddon is not present in the remote state, sunpikeAddonsMap.
-					// Make the remote state same as the plan state by enabling the addon.
-					tflog.Debug(ctx, "Enabling addon", map[string]interface{}{"addon": addonName})
-					defaultAddonVersions, err := r.client.Qbert().ListSupportedAddonVersions(ctx, clusterID)
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to get default addon versions", err.Error())
-						return
-					}
-					tfParamsInPlan := map[string]types.String{}
-					if !tfAddon.Params.IsUnknown() {
-						resp.Diagnostics.Append(tfAddon.Params.ElementsAs(ctx, &tfParamsInPlan, false)...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-					}
-					paramsInPlan := map[string]string{}
-					for key, value := range tfParamsInPlan {
-						// TODO: Decide how to handle null values. Currently they are sent as empty strings.
-						paramsInPlan[key] = value.ValueString()
-					}
-					var addonVersion string
-					if !tfAddon.Version.IsNull() && !tfAddon.Version.IsUnknown() {
-						addonVersion = tfAddon.Version.ValueString()
-					} else {
-						addonVersion = getDefaultAddonVersion(defaultAddonVersions, addonName)
-					}
-					if addonVersion == "" {
-						resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(addonName),
-							"Failed to get addon version", fmt.Sprintf("Unrecognized addon name %s or missing version in the API response", addonName))
-						return
-					}
-					err = r.addonsClient.Enable(ctx, AddonSpec{
-						ClusterID: clusterID,
-						Type:      addonName,
-						Version:   addonVersion,
-						ParamsMap: paramsInPlan,
-					})
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to enable addon", err.Error())
-						return
-					}
-				}
-			}
-			for addonName := range sunpikeAddonsMap {
-				if _, found := tfAddonsMap[addonName]; !found {
-					// Case 3:
-					// The addon is present in the remote state, sunpikeAddonsMap
-					// but not present in the plan, tfAddonsMap. Disabling the addon
-					// will make the remote state same as the plan state.
-					tflog.Debug(ctx, "Disabling addon", map[string]interface{}{"addon": addonName})
-					err = r.addonsClient.Disable(ctx, AddonSpec{
-						ClusterID: clusterID,
-						Type:      addonName,
-					})
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to disable addon", err.Error())
-						return
-					}
-				}
-			}
-		}
-	} // end of addons reconcilation
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(r.readStateFromRemote(ctx, clusterID, projectID, &state, &data)...)
@@ -533,6 +434,7 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	state.Addons = tfAddonsRemote
 	// This attr is useful in Update only, copied value from state to prevent inconsistency
 	state.BatchUpgradePercent = data.BatchUpgradePercent
+	state.Timeouts = data.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -579,6 +481,8 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// timeouts exist only in the configuration
+	state.Timeouts = data.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -678,159 +582,15 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		tflog.Debug(ctx, "No change detected, skipping edit cluster")
 	}
 
-	sunpikeAddons, err := r.listClusterAddons(ctx, clusterID)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to get cluster addons", err.Error())
-		return
-	}
-	sunpikeAddonsMap := map[string]sunpikev1alpha2.ClusterAddon{}
-	for _, sunpikeAddon := range sunpikeAddons {
-		sunpikeAddonsMap[sunpikeAddon.Spec.Type] = sunpikeAddon
-	}
-	// Load plan addons into a map
 	if !plan.Addons.IsNull() && !plan.Addons.IsUnknown() {
-		tfAddonsMap := map[string]resource_cluster.AddonsValue{}
-		resp.Diagnostics.Append(plan.Addons.ElementsAs(ctx, &tfAddonsMap, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		defaultAddonVersions, err := r.client.Qbert().ListSupportedAddonVersions(ctx, clusterID)
+		timeout, err := parseAddonTimeout(plan.Timeouts.Update)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to get default addon versions", err.Error())
+			resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("update"), "Invalid timeout", err.Error())
 			return
 		}
-		for addonName, tfAddon := range tfAddonsMap {
-			if sunpikeAddon, found := sunpikeAddonsMap[addonName]; !tfAddon.IsNull() {
-				if !tfAddon.Enabled.IsNull() && !tfAddon.Enabled.IsUnknown() && !tfAddon.Enabled.ValueBool() {
-					tflog.Debug(ctx, "Disabling addon because enabled=false", map[string]interface{}{"addon": addonName})
-					err = r.addonsClient.Disable(ctx, AddonSpec{ClusterID: clusterID, Type: addonName})
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to disable addon", err.Error())
-						return
-					}
-					continue
-				}
-				if found {
-					// Patch the addon
-					tflog.Debug(ctx, "Checking if addon version and params needs to be patched")
-					var addonVersion string
-					if tfAddon.Version.IsNull() || tfAddon.Version.IsUnknown() {
-						tflog.Debug(ctx, "Version is not provided in the plan, getting default version")
-						addonVersion = getDefaultAddonVersion(defaultAddonVersions, addonName)
-					} else {
-						addonVersion = tfAddon.Version.ValueString()
-					}
-					tfParamsInPlan := map[string]types.String{}
-					if !tfAddon.Params.IsUnknown() {
-						resp.Diagnostics.Append(tfAddon.Params.ElementsAs(ctx, &tfParamsInPlan, false)...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-					}
-					paramsInPlan := map[string]string{}
-					for key, value := range tfParamsInPlan {
-						paramsInPlan[key] = value.ValueString()
-					}
-					err := r.addonsClient.Patch(ctx, AddonSpec{
-						ClusterID: clusterID,
-						Type:      addonName,
-						Version:   addonVersion,
-						ParamsMap: paramsInPlan,
-					}, &sunpikeAddon)
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to patch addon", err.Error())
-						return
-					}
-				} else {
-					// Enable the addon
-					tflog.Debug(ctx, "Enabling addon", map[string]interface{}{"addon": addonName})
-					tfParamsInPlan := map[string]types.String{}
-					if !tfAddon.Params.IsUnknown() {
-						resp.Diagnostics.Append(tfAddon.Params.ElementsAs(ctx, &tfParamsInPlan, false)...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-					}
-					paramsInPlan := map[string]string{}
-					for key, value := range tfParamsInPlan {
-						paramsInPlan[key] = value.ValueString()
-					}
-					var addonVersion string
-					if !tfAddon.Version.IsNull() && !tfAddon.Version.IsUnknown() {
-						addonVersion = tfAddon.Version.ValueString()
-					} else {
-						addonVersion = getDefaultAddonVersion(defaultAddonVersions, addonName)
-					}
-					if addonVersion == "" {
-						resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(addonName),
-							"Failed to get addon version", fmt.Sprintf("Unrecognized addon name %s or missing version in the API response", addonName))
-						return
-					}
-					err = r.addonsClient.Enable(ctx, AddonSpec{
-						ClusterID: clusterID,
-						Type:      addonName,
-						Version:   addonVersion,
-						ParamsMap: paramsInPlan,
-					})
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to enable addon", err.Error())
-						return
-					}
-				}
-			} else if tfAddon.IsNull() {
-				// Disable the addon because it is null in the plan
-				tflog.Debug(ctx, "Disabling addon", map[string]interface{}{"addon": addonName})
-				err = r.addonsClient.Disable(ctx, AddonSpec{ClusterID: clusterID, Type: addonName})
-				if err != nil {
-					resp.Diagnostics.AddError("Failed to disable addon", err.Error())
-					return
-				}
-			} else {
-				// Enable the addon
-				tflog.Debug(ctx, "Enabling addon", map[string]interface{}{"addon": addonName})
-				tfParamsInPlan := map[string]types.String{}
-				if !tfAddon.Params.IsUnknown() {
-					resp.Diagnostics.Append(tfAddon.Params.ElementsAs(ctx, &tfParamsInPlan, false)...)
-					if resp.Diagnostics.HasError() {
-						return
-					}
-				}
-				paramsInPlan := map[string]string{}
-				for key, value := range tfParamsInPlan {
-					paramsInPlan[key] = value.ValueString()
-				}
-				var addonVersion string
-				if !tfAddon.Version.IsNull() && !tfAddon.Version.IsUnknown() {
-					addonVersion = tfAddon.Version.ValueString()
-				} else {
-					addonVersion = getDefaultAddonVersion(defaultAddonVersions, addonName)
-				}
-				if addonVersion == "" {
-					resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(addonName),
-						"Failed to get addon version", fmt.Sprintf("Unrecognized addon name %s or missing version in the API response", addonName))
-					return
-				}
-				err = r.addonsClient.Enable(ctx, AddonSpec{
-					ClusterID: clusterID,
-					Type:      addonName,
-					Version:   addonVersion,
-					ParamsMap: paramsInPlan,
-				})
-				if err != nil {
-					resp.Diagnostics.AddError("Failed to enable addon", err.Error())
-					return
-				}
-			}
-		}
-		for addonName := range sunpikeAddonsMap {
-			if _, found := tfAddonsMap[addonName]; !found {
-				tflog.Debug(ctx, "Disabling addon", map[string]interface{}{"addon": addonName})
-				err = r.addonsClient.Disable(ctx, AddonSpec{ClusterID: clusterID, Type: addonName})
-				if err != nil {
-					resp.Diagnostics.AddError("Failed to disable addon", err.Error())
-					return
-				}
-			}
+		resp.Diagnostics.Append(r.reconcileClusterAddons(ctx, clusterID, plan.Addons, timeout)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -891,7 +651,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Save data into Terraform state
-	sunpikeAddons, err = r.listClusterAddons(ctx, clusterID)
+	sunpikeAddons, err := r.listClusterAddons(ctx, clusterID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get cluster addons", err.Error())
 		return
@@ -911,6 +671,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	// To prevent inconsistency. This attr is read only in case
 	// of upgrade cluster, it is not associated with any remote attribute
 	state.BatchUpgradePercent = plan.BatchUpgradePercent
+	state.Timeouts = plan.Timeouts
 	if !plan.MasterVipIpv4.IsUnknown() {
 		state.MasterVipIpv4 = plan.MasterVipIpv4
 	}
@@ -953,6 +714,23 @@ func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// getClusterAPIEndpoint returns the URL of the Kubernetes API of the cluster. It falls back to the
+// server in the kubeconfig if the cluster has neither an external DNS name nor a master IP.
+func getClusterAPIEndpoint(qbertCluster *qbert.Cluster, apiServer Cluster) string {
+	host := qbertCluster.ExternalDNSName
+	if host == "" {
+		host = qbertCluster.MasterIP
+	}
+	if host == "" {
+		return apiServer.Cluster.Server
+	}
+	port := qbertCluster.K8sAPIPort
+	if port == "" {
+		port = "443"
+	}
+	return "https://" + net.JoinHostPort(host, port)
+}
+
 // readStateFromRemote sets the values of the attributes in the state variable retrieved from the backend
 func (r *clusterResource) readStateFromRemote(ctx context.Context, clusterID, projectID string, state *resource_cluster.ClusterModel, plan *resource_cluster.ClusterModel) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -969,6 +747,23 @@ func (r *clusterResource) readStateFromRemote(ctx context.Context, clusterID, pr
 		return diags
 	}
 
+	// The kubeconfig is not available until the API server of the cluster is up, the rest of
+	// the cluster is still read and the API server attributes keep their prior values
+	apiServer, err := getClusterAPIServer(ctx, r.client, clusterID)
+	if err != nil {
+		diags.AddWarning("Failed to read API server of the cluster",
+			fmt.Sprintf("api_endpoint and cluster_ca_certificate are not refreshed: %v", err))
+		if state.ApiEndpoint.IsUnknown() {
+			state.ApiEndpoint = types.StringNull()
+		}
+		if state.ClusterCaCertificate.IsUnknown() {
+			state.ClusterCaCertificate = types.StringNull()
+		}
+	} else {
+		state.ApiEndpoint = types.StringValue(getClusterAPIEndpoint(cluster, apiServer))
+		state.ClusterCaCertificate = getStrOrNullIfEmpty(apiServer.Cluster.CertificateAuthorityData)
+	}
+
 	tflog.Info(ctx, "Listing nodes attached to the cluster", map[string]interface{}{"clusterID": clusterID})
 	clusterNodes, err := r.client.Qbert().ListClusterNodes(ctx, clusterID)
 	if err != nil {
@@ -984,12 +779,15 @@ func (r *clusterResource) readStateFromRemote(ctx context.Context, clusterID, pr
 			workerNodes = append(workerNodes, node.UUID)
 		}
 	}
-	state.MasterNodes, diags = types.SetValueFrom(ctx, types.StringType, masterNodes)
+	var setDiags diag.Diagnostics
+	state.MasterNodes, setDiags = types.SetValueFrom(ctx, types.StringType, masterNodes)
+	diags.Append(setDiags...)
 	if diags.HasError() {
 		return diags
 	}
 	if len(workerNodes) > 0 {
-		state.WorkerNodes, diags = types.SetValueFrom(ctx, types.StringType, workerNodes)
+		state.WorkerNodes, setDiags = types.SetValueFrom(ctx, types.StringType, workerNodes)
+		diags.Append(setDiags...)
 		if diags.HasError() {
 			return diags
 		}
@@ -1112,9 +910,8 @@ func (r *clusterResource) attachDetachNodes(ctx context.Context, clusterID strin
 		})
 		nodesToAttachIDs = append(nodesToAttachIDs, nodeID)
 	}
-	err = r.verifyNodesForAttach(ctx, nodesToAttachIDs, qbertNodesMap)
-	if err != nil {
-		diags.AddError("Failed to verify nodes are eligible to attach", err.Error())
+	diags.Append(r.verifyNodesForAttach(ctx, nodesToAttachIDs, qbertNodesMap, plan.ContainersCidr.ValueString(), plan.ServicesCidr.ValueString())...)
+	if diags.HasError() {
 		return diags
 	}
 	if len(nodeList) > 0 {
@@ -1145,21 +942,181 @@ func (r *clusterResource) getQbertNodesMap(projectID string) (map[string]qbert.N
 	return nodesMap, nil
 }
 
-func (r *clusterResource) verifyNodesForAttach(ctx context.Context, nodesToAttachIDs []string, qbertNodesMap map[string]qbert.Node) error {
+// verifyPlannedNodesForAttach runs the attach checks at plan time for the nodes that the plan
+// adds to the cluster, so that ineligible nodes are reported before anything is created.
+// Node IDs that are missing, not "ok" or attached to another cluster are reported against
+// master_nodes/worker_nodes. Nodes whose IDs are not known yet are verified again during apply.
+func (r *clusterResource) verifyPlannedNodesForAttach(ctx context.Context, projectID string, plan resource_cluster.ClusterModel, state resource_cluster.ClusterModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	addedNodeIDs := map[string][]string{}
+	totalAdded := 0
+	for attrName, sets := range map[string][2]types.Set{
+		"master_nodes": {state.MasterNodes, plan.MasterNodes},
+		"worker_nodes": {state.WorkerNodes, plan.WorkerNodes},
+	} {
+		var strIDs [2][]string
+		for i, nodesSet := range sets {
+			if nodesSet.IsNull() || nodesSet.IsUnknown() {
+				continue
+			}
+			nodeIDs := []types.String{}
+			diags.Append(nodesSet.ElementsAs(ctx, &nodeIDs, false)...)
+			if diags.HasError() {
+				return diags
+			}
+			for _, nodeID := range nodeIDs {
+				if !nodeID.IsNull() && !nodeID.IsUnknown() {
+					strIDs[i] = append(strIDs[i], nodeID.ValueString())
+				}
+			}
+		}
+		addedNodeIDs[attrName] = findDiff(strIDs[0], strIDs[1]).Added
+		totalAdded += len(addedNodeIDs[attrName])
+	}
+	if totalAdded == 0 {
+		return diags
+	}
+	qbertNodesMap, err := r.getQbertNodesMap(projectID)
+	if err != nil {
+		diags.AddError("Failed to get qbert nodes", err.Error())
+		return diags
+	}
+	clusterID := state.Id.ValueString()
+	nodesToAttachIDs := []string{}
+	for _, attrName := range []string{"master_nodes", "worker_nodes"} {
+		for _, nodeID := range addedNodeIDs[attrName] {
+			// Role changes within the same cluster detach the node first, skip them here
+			if node, found := qbertNodesMap[nodeID]; found && clusterID != "" && node.ClusterUUID == clusterID {
+				continue
+			}
+			if problem := getQbertNodeAttachProblem(nodeID, qbertNodesMap); problem != "" {
+				diags.AddAttributeError(path.Root(attrName), "Node cannot be attached", problem)
+				continue
+			}
+			nodesToAttachIDs = append(nodesToAttachIDs, nodeID)
+		}
+	}
+	if diags.HasError() || len(nodesToAttachIDs) == 0 {
+		return diags
+	}
+	var containersCidr, servicesCidr string
+	if !plan.ContainersCidr.IsUnknown() {
+		containersCidr = plan.ContainersCidr.ValueString()
+	}
+	if !plan.ServicesCidr.IsUnknown() {
+		servicesCidr = plan.ServicesCidr.ValueString()
+	}
+	diags.Append(r.verifyNodesForAttach(ctx, nodesToAttachIDs, qbertNodesMap, containersCidr, servicesCidr)...)
+	return diags
+}
+
+// getQbertNodeAttachProblem returns the reason why qbert would refuse to attach the node,
+// or an empty string if the node exists, is healthy and is not part of any cluster.
+func getQbertNodeAttachProblem(nodeID string, qbertNodesMap map[string]qbert.Node) string {
+	node, found := qbertNodesMap[nodeID]
+	if !found {
+		return fmt.Sprintf("node %v not found", nodeID)
+	}
+	if node.Status != "ok" {
+		return fmt.Sprintf("node %v is not in a 'ok' state. Current state:%v", nodeID, node.Status)
+	}
+	if node.ClusterName != "" {
+		return fmt.Sprintf("node %v is already attached to a cluster %v", nodeID, node.ClusterName)
+	}
+	return ""
+}
+
+// Hosts with any of these roles are already serving another Platform9 product
+// and cannot be turned into kubernetes nodes.
+var conflictingHostRolePrefixes = []string{"pf9-ostackhost", "pf9-glance-role", "pf9-cindervolume"}
+
+// kubeHostRole is the resmgr role that turns a host into a kubernetes node. resmgr only converges it
+// on the operating systems and architectures that the DU ships packages for.
+const kubeHostRole = "pf9-kube"
+
+// getHostAttachProblems returns the reasons why the resmgr host cannot become a node of a cluster
+func getHostAttachProblems(nodeID string, host resmgr.Host) []string {
+	problems := []string{}
+	if !host.Info.Responding {
+		problems = append(problems, fmt.Sprintf("node %v is not responding", nodeID))
+	}
+	for _, role := range host.Roles {
+		if hasAnyPrefix(role, conflictingHostRolePrefixes) {
+			problems = append(problems, fmt.Sprintf("node %v has a conflicting role %v", nodeID, role))
+		}
+	}
+	platformProblem := ""
+	switch {
+	case !StrSliceContains(host.Roles, kubeHostRole):
+		platformProblem = fmt.Sprintf("node %v (%v, %v) does not have the %v role", nodeID, host.Info.OSInfo, host.Info.Arch, kubeHostRole)
+	case host.RoleStatus != "ok":
+		platformProblem = fmt.Sprintf("the %v role of node %v (%v, %v) is in the %v state", kubeHostRole, nodeID,
+			host.Info.OSInfo, host.Info.Arch, host.RoleStatus)
+	}
+	if platformProblem != "" {
+		if host.Message != "" {
+			platformProblem += ": " + host.Message
+		}
+		problems = append(problems, platformProblem)
+	}
+	return problems
+}
+
+// verifyNodesForAttach checks that every node is known to qbert and resmgr, is free, healthy and
+// compatible with the cluster. The operating system and architecture are checked by resmgr, which
+// converges the pf9-kube role only on supported hosts. All ineligible nodes are reported together in
+// a single error.
+// containersCidr and servicesCidr are skipped when empty.
+func (r *clusterResource) verifyNodesForAttach(ctx context.Context, nodesToAttachIDs []string, qbertNodesMap map[string]qbert.Node, containersCidr string, servicesCidr string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	tflog.Debug(ctx, "Checking if nodes can be attached", map[string]interface{}{"nodesToAttachIDs": nodesToAttachIDs})
+	clusterCidrs := []*net.IPNet{}
+	for _, cidr := range []string{containersCidr, servicesCidr} {
+		if cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			diags.AddError("Failed to verify nodes", fmt.Sprintf("failed to parse cidr %v: %v", cidr, err))
+			return diags
+		}
+		clusterCidrs = append(clusterCidrs, ipNet)
+	}
+	problems := []string{}
+	ineligibleNodeIDs := map[string]bool{}
+	addProblem := func(nodeID string, problem string) {
+		problems = append(problems, problem)
+		ineligibleNodeIDs[nodeID] = true
+	}
 	for _, nodeID := range nodesToAttachIDs {
+		if problem := getQbertNodeAttachProblem(nodeID, qbertNodesMap); problem != "" {
+			addProblem(nodeID, problem)
+		}
 		node, found := qbertNodesMap[nodeID]
 		if !found {
-			return fmt.Errorf("node %v not found", nodeID)
+			continue
 		}
-		if node.Status != "ok" {
-			return fmt.Errorf("node %v is not in a 'ok' state. Current state:%v", nodeID, node.Status)
+		if ip := net.ParseIP(node.PrimaryIP); ip != nil {
+			for _, ipNet := range clusterCidrs {
+				if ipNet.Contains(ip) {
+					addProblem(nodeID, fmt.Sprintf("node %v primary IP %v overlaps with the cluster cidr %v", nodeID, node.PrimaryIP, ipNet.String()))
+				}
+			}
 		}
-		if node.ClusterName != "" {
-			return fmt.Errorf("node %v is already attached to a cluster %v", nodeID, node.ClusterName)
+		host, err := r.client.Resmgr().GetHost(ctx, nodeID)
+		if err != nil {
+			diags.AddError("Failed to verify nodes", fmt.Sprintf("failed to get host %v: %v", nodeID, err))
+			return diags
 		}
+		for _, problem := range getHostAttachProblems(nodeID, *host) {
+			addProblem(nodeID, problem)
+		}
+	}
+	if len(problems) > 0 {
+		diags.AddError("Nodes are not eligible to attach",
+			fmt.Sprintf("%d node(s) cannot be attached:\n%v", len(ineligibleNodeIDs), strings.Join(problems, "\n")))
 	}
-	return nil
+	return diags
 }
 
 func getParamsMapForAllAddons(ctx context.Context, planAddons types.Map) (map[string]map[string]types.String, diag.Diagnostics) {
@@ -1195,6 +1152,13 @@ func sunpikeAddonsToTerraformAddons(ctx context.Context, sunpikeAddons []sunpike
 	if diags.HasError() {
 		return tfAddonsMap, diags
 	}
+	tfPlanAddonsMap := map[string]resource_cluster.AddonsValue{}
+	if !planAddons.IsNull() && !planAddons.IsUnknown() {
+		diags = planAddons.ElementsAs(ctx, &tfPlanAddonsMap, false)
+		if diags.HasError() {
+			return tfAddonsMap, diags
+		}
+	}
 	for _, sunpikeAddon := range sunpikeAddons {
 		addonName := sunpikeAddon.Spec.Type
 		version := types.StringValue(sunpikeAddon.Spec.Version)
@@ -1210,17 +1174,61 @@ func sunpikeAddonsToTerraformAddons(ctx context.Context, sunpikeAddons []sunpike
 			}
 			paramMap[param.Name] = types.StringValue(param.Value)
 		}
+		var typedBlock basetypes.ObjectValue
+		versionConstraint := types.StringNull()
+		ignoreParams := types.SetNull(types.StringType)
+		sensitiveParams := types.MapNull(types.StringType)
+		sensitiveParamsHash := types.MapNull(types.StringType)
+		if tfPlanAddon, found := tfPlanAddonsMap[addonName]; found && !tfPlanAddon.IsNull() {
+			versionConstraint = tfPlanAddon.VersionConstraint
+			ignoreParams = tfPlanAddon.IgnoreParams
+			ignoreParamKeys, diags := getIgnoreParams(ctx, tfPlanAddon.IgnoreParams)
+			if diags.HasError() {
+				return tfAddonsMap, diags
+			}
+			// ignored params are managed outside of Terraform, keep them out of the state
+			for _, paramName := range ignoreParamKeys {
+				delete(paramMap, paramName)
+			}
+			sensitiveParams, sensitiveParamsHash, diags = sunpikeSensitiveParamsToTerraform(ctx, tfPlanAddon.SensitiveParams,
+				tfPlanAddon.SensitiveParamsHash, convertParamsToMap(sunpikeAddon.Spec.Override.Params))
+			if diags.HasError() {
+				return tfAddonsMap, diags
+			}
+			// sensitive params are tracked by sensitive_params, keep them out of params
+			for paramName := range tfPlanAddon.SensitiveParams.Elements() {
+				delete(paramMap, paramName)
+			}
+			// typed block is kept in the state only if it was set in the plan, its params
+			// are then tracked by the block and removed from the params map
+			planBlock, isTyped := getTypedAddonBlocks(tfPlanAddon)[addonName]
+			if isTyped && !planBlock.IsNull() && !planBlock.IsUnknown() {
+				typedBlock, diags = typedAddonBlockFromParams(ctx, addonName, planBlock, convertParamsToMap(sunpikeAddon.Spec.Override.Params))
+				if diags.HasError() {
+					return tfAddonsMap, diags
+				}
+				for _, paramName := range typedAddonParamKeys[addonName] {
+					delete(paramMap, paramName)
+				}
+			}
+		}
 		var params types.Map
 		params, diags = types.MapValueFrom(ctx, types.StringType, paramMap)
 		if diags.HasError() {
 			return tfAddonsMap, diags
 		}
-		addonObjVal, diags := resource_cluster.AddonsValue{
-			Enabled: types.BoolValue(true),
-			Version: version,
-			Phase:   phase,
-			Params:  params,
-		}.ToObjectValue(ctx)
+		addonValue := resource_cluster.AddonsValue{
+			Enabled:             types.BoolValue(true),
+			Version:             version,
+			VersionConstraint:   versionConstraint,
+			Phase:               phase,
+			Params:              params,
+			IgnoreParams:        ignoreParams,
+			SensitiveParams:     sensitiveParams,
+			SensitiveParamsHash: sensitiveParamsHash,
+		}
+		setTypedAddonBlock(&addonValue, addonName, typedBlock)
+		addonObjVal, diags := addonValue.ToObjectValue(ctx)
 		if diags.HasError() {
 			return tfAddonsMap, diags
 		}
@@ -1231,11 +1239,6 @@ func sunpikeAddonsToTerraformAddons(ctx context.Context, sunpikeAddons []sunpike
 		tfAddonsMap[sunpikeAddon.Spec.Type] = addonObjValuable.(resource_cluster.AddonsValue)
 	}
 	if !planAddons.IsNull() && !planAddons.IsUnknown() {
-		tfPlanAddonsMap := map[string]resource_cluster.AddonsValue{}
-		diags := planAddons.ElementsAs(ctx, &tfPlanAddonsMap, false)
-		if diags.HasError() {
-			return tfAddonsMap, diags
-		}
 		for addonName, tfPlanAddon := range tfPlanAddonsMap {
 			if _, found := tfAddonsMap[addonName]; !found {
 				if tfPlanAddon.IsNull() {
@@ -1252,11 +1255,29 @@ func sunpikeAddonsToTerraformAddons(ctx context.Context, sunpikeAddons []sunpike
 					if !tfPlanAddon.Version.IsUnknown() {
 						tmpVersion = tfPlanAddon.Version
 					}
+					tmpVersionConstraint := types.StringNull()
+					if !tfPlanAddon.VersionConstraint.IsUnknown() {
+						tmpVersionConstraint = tfPlanAddon.VersionConstraint
+					}
+					tmpSensitiveParams := types.MapNull(types.StringType)
+					tmpSensitiveParamsHash := types.MapNull(types.StringType)
+					if !tfPlanAddon.SensitiveParams.IsUnknown() {
+						tmpSensitiveParams = tfPlanAddon.SensitiveParams
+						tmpSensitiveParamsHash = tfPlanAddon.SensitiveParamsHash
+					}
 					addonObjVal, convertDiags := resource_cluster.AddonsValue{
-						Enabled: types.BoolValue(false),
-						Params:  tmpParams,
-						Phase:   types.StringNull(),
-						Version: tmpVersion,
+						Enabled:             types.BoolValue(false),
+						Params:              tmpParams,
+						Phase:               types.StringNull(),
+						Version:             tmpVersion,
+						VersionConstraint:   tmpVersionConstraint,
+						IgnoreParams:        tfPlanAddon.IgnoreParams,
+						SensitiveParams:     tmpSensitiveParams,
+						SensitiveParamsHash: tmpSensitiveParamsHash,
+						Coredns:             tfPlanAddon.Coredns,
+						Metallb:             tfPlanAddon.Metallb,
+						MetricsServer:       tfPlanAddon.MetricsServer,
+						Monitoring:          tfPlanAddon.Monitoring,
 					}.ToObjectValue(ctx)
 					diags.Append(convertDiags...)
 					if diags.HasError() {
@@ -1275,6 +1296,44 @@ func sunpikeAddonsToTerraformAddons(ctx context.Context, sunpikeAddons []sunpike
 	return tfAddonsMap, diags
 }
 
+// sunpikeSensitiveParamsToTerraform returns the sensitive params and their hashes to be kept in the state.
+// A sensitive param whose remote value does not match the recorded hash was changed outside of Terraform,
+// it is dropped from the state so that the next plan restores it.
+func sunpikeSensitiveParamsToTerraform(ctx context.Context, planSensitiveParamsMap types.Map, planSensitiveParamsHash types.Map,
+	remoteParams map[string]string) (types.Map, types.Map, diag.Diagnostics) {
+	if planSensitiveParamsMap.IsNull() || planSensitiveParamsMap.IsUnknown() {
+		return types.MapNull(types.StringType), types.MapNull(types.StringType), nil
+	}
+	planSensitiveParams, diags := getSensitiveParams(ctx, planSensitiveParamsMap)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), types.MapNull(types.StringType), diags
+	}
+	recordedHashes := map[string]string{}
+	if !planSensitiveParamsHash.IsNull() && !planSensitiveParamsHash.IsUnknown() {
+		diags.Append(planSensitiveParamsHash.ElementsAs(ctx, &recordedHashes, false)...)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), types.MapNull(types.StringType), diags
+		}
+	}
+	hashes, err := hashSensitiveParams(planSensitiveParams, recordedHashes)
+	if err != nil {
+		diags.AddError("Failed to hash the sensitive params", err.Error())
+		return types.MapNull(types.StringType), types.MapNull(types.StringType), diags
+	}
+	stateSensitiveParams := map[string]string{}
+	for key, value := range planSensitiveParams {
+		remoteValue, found := remoteParams[key]
+		if found && paramHashMatches(hashes[key], remoteValue) {
+			stateSensitiveParams[key] = value
+		}
+	}
+	sensitiveParams, convertDiags := types.MapValueFrom(ctx, types.StringType, stateSensitiveParams)
+	diags.Append(convertDiags...)
+	sensitiveParamsHash, convertDiags := types.MapValueFrom(ctx, types.StringType, hashes)
+	diags.Append(convertDiags...)
+	return sensitiveParams, sensitiveParamsHash, diags
+}
+
 func qbertClusterToTerraformCluster(ctx context.Context, qbertCluster *qbert.Cluster, clusterModel *resource_cluster.ClusterModel, stateTags types.Map) diag.Diagnostics {
 	var diags diag.Diagnostics
 	clusterModel.Id = types.StringValue(qbertCluster.UUID)
@@ -2,13 +2,21 @@ package provider
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sunpikev1alpha2 "github.com/platform9/pf9-sdk-go/pf9/apis/sunpike/v1alpha2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -17,22 +25,123 @@ type AddonSpec struct {
 	Version   string
 	Type      string
 	ParamsMap map[string]string
+	// IgnoreParams are managed outside of Terraform, they are not compared and keep their current values
+	IgnoreParams []string
+	// SensitiveParams are the keys of ParamsMap holding secrets, their values are redacted in logs
+	SensitiveParams []string
+	// Timeout bounds the wait for the addon to be installed or removed, the default of the addon type applies when zero
+	Timeout time.Duration
 }
 
 type AddonsClient interface {
-	Get(ctx context.Context, addonName string) (sunpikev1alpha2.ClusterAddon, error)
+	// Get returns the addon of the given type on the cluster, found by its labels
+	Get(ctx context.Context, clusterID string, addonType string) (sunpikev1alpha2.ClusterAddon, error)
 	List(ctx context.Context, clusterID string, addonType string) ([]sunpikev1alpha2.ClusterAddon, error)
 	Enable(ctx context.Context, addonSpec AddonSpec) error
 	Disable(ctx context.Context, addonSpec AddonSpec) error
 	Patch(ctx context.Context, addonSpec AddonSpec, refClusterAddon *sunpikev1alpha2.ClusterAddon) error
+	// WaitForInstall blocks until the controller has observed the latest spec of the addon and the addon
+	// reaches the Installed phase, fails or times out
+	WaitForInstall(ctx context.Context, addonSpec AddonSpec) (sunpikev1alpha2.ClusterAddon, error)
+}
+
+const (
+	redactedParamValue  = "(sensitive value)"
+	addonPhaseInstalled = "Installed"
+	addonPollInterval   = 10 * time.Second
+	// Addons are installed only after the cluster is up, the timeouts include the cluster bring-up time
+	defaultAddonInstallTimeout = 20 * time.Minute
+	addonDeleteTimeout         = 10 * time.Minute
+)
+
+// addonFailedPhases are the phases in which the controller gave up on installing the addon
+var addonFailedPhases = map[string]bool{
+	"InstallError":   true,
+	"UpgradeError":   true,
+	"UninstallError": true,
+}
+
+// Some addons take much longer than others to install
+var addonInstallTimeouts = map[string]time.Duration{
+	"monitoring": 30 * time.Minute,
+	"kubevirt":   30 * time.Minute,
+}
+
+func getAddonInstallTimeout(addonSpec AddonSpec) time.Duration {
+	if addonSpec.Timeout > 0 {
+		return addonSpec.Timeout
+	}
+	if timeout, ok := addonInstallTimeouts[addonSpec.Type]; ok {
+		return timeout
+	}
+	return defaultAddonInstallTimeout
+}
+
+func getAddonDeleteTimeout(addonSpec AddonSpec) time.Duration {
+	if addonSpec.Timeout > 0 {
+		return addonSpec.Timeout
+	}
+	return addonDeleteTimeout
+}
+
+// parseAddonTimeout parses a timeout set in the timeouts attribute, zero if it is not set
+func parseAddonTimeout(timeout types.String) (time.Duration, error) {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(timeout.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %v: %w", timeout.ValueString(), err)
+	}
+	return duration, nil
+}
+
+const (
+	defaultSunpikeNamespace  = "default"
+	defaultAddonNameTemplate = "{cluster_id}-{type}"
+	addonClusterIDLabel      = "sunpike.pf9.io/cluster"
+	addonTypeLabel           = "type"
+)
+
+// AddonsClientConfig holds the sunpike layout of the ClusterAddon objects, set in the provider configuration
+type AddonsClientConfig struct {
+	Namespace string
+	// NameTemplate is the name of new objects, {cluster_id} and {type} are replaced with the cluster UUID and addon type
+	NameTemplate string
+}
+
+// DefaultAddonsClientConfig returns the layout used by the DUs unless configured otherwise
+func DefaultAddonsClientConfig() AddonsClientConfig {
+	return AddonsClientConfig{
+		Namespace:    defaultSunpikeNamespace,
+		NameTemplate: defaultAddonNameTemplate,
+	}
+}
+
+// Validate checks that the name template yields a unique name per cluster and addon type
+func (c AddonsClientConfig) Validate() error {
+	if c.Namespace == "" {
+		return fmt.Errorf("sunpike namespace cannot be empty")
+	}
+	for _, placeholder := range []string{"{cluster_id}", "{type}"} {
+		if !strings.Contains(c.NameTemplate, placeholder) {
+			return fmt.Errorf("addon name template %q must contain the placeholder %v", c.NameTemplate, placeholder)
+		}
+	}
+	return nil
+}
+
+func (c AddonsClientConfig) addonName(clusterID string, addonType string) string {
+	return strings.NewReplacer("{cluster_id}", clusterID, "{type}", addonType).Replace(c.NameTemplate)
 }
 
 type addonsClient struct {
 	client client.Client
+	config AddonsClientConfig
 }
 
-func NewAddonClient(sunpikeClient client.Client) AddonsClient {
-	return &addonsClient{client: sunpikeClient}
+func NewAddonClient(sunpikeClient client.Client, config AddonsClientConfig) AddonsClient {
+	return &addonsClient{client: sunpikeClient, config: config}
 }
 
 func (r *addonsClient) Enable(ctx context.Context, spec AddonSpec) error {
@@ -43,13 +152,27 @@ func (r *addonsClient) Enable(ctx context.Context, spec AddonSpec) error {
 			Value: value,
 		})
 	}
+	addonName := r.config.addonName(spec.ClusterID, spec.Type)
+	existingAddons, err := r.List(ctx, spec.ClusterID, spec.Type)
+	if err != nil {
+		return fmt.Errorf("failed to list addon %v: %w", spec.Type, err)
+	}
+	if len(existingAddons) > len(getActiveAddons(existingAddons)) {
+		// The addon was disabled recently and is still being uninstalled, creating
+		// it now would fail with AlreadyExists until the finalizers are removed
+		tflog.Info(ctx, "Waiting for the previous instance of the addon to be removed", map[string]interface{}{"addon": addonName})
+		err = r.waitForDeletion(ctx, spec)
+		if err != nil {
+			return err
+		}
+	}
 	return r.client.Create(ctx, &sunpikev1alpha2.ClusterAddon{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-%s", spec.ClusterID, spec.Type),
-			Namespace: "default",
+			Name:      addonName,
+			Namespace: r.config.Namespace,
 			Labels: map[string]string{
-				"sunpike.pf9.io/cluster": spec.ClusterID,
-				"type":                   spec.Type,
+				addonClusterIDLabel: spec.ClusterID,
+				addonTypeLabel:      spec.Type,
 			},
 		},
 		Spec: sunpikev1alpha2.ClusterAddonSpec{
@@ -66,13 +189,14 @@ func (r *addonsClient) Patch(ctx context.Context,
 	planAddonSpec AddonSpec, stateAddon *sunpikev1alpha2.ClusterAddon) error {
 	var isParamPatchNeeded bool
 	stateParamsMap := convertParamsToMap(stateAddon.Spec.Override.Params)
-	if !StrMap(planAddonSpec.ParamsMap).Equals(StrMap(stateParamsMap)) {
+	if !StrMap(withoutParams(planAddonSpec.ParamsMap, planAddonSpec.IgnoreParams)).Equals(
+		StrMap(withoutParams(stateParamsMap, planAddonSpec.IgnoreParams))) {
 		tflog.Debug(ctx, "Override params do not match with the plan, patch required",
 			map[string]interface{}{
 				"tfAddon":       planAddonSpec.Type,
 				"sunpikeAddon":  stateAddon.Spec.Type,
-				"tfParams":      planAddonSpec.ParamsMap,
-				"sunpikeParams": stateParamsMap,
+				"tfParams":      redactParams(planAddonSpec.ParamsMap, planAddonSpec.SensitiveParams),
+				"sunpikeParams": redactParams(stateParamsMap, planAddonSpec.SensitiveParams),
 			})
 		isParamPatchNeeded = true
 	}
@@ -84,8 +208,14 @@ func (r *addonsClient) Patch(ctx context.Context,
 	tflog.Debug(ctx, "Patching addon", map[string]interface{}{"addon": stateAddon.Spec.Type})
 	patch := client.MergeFrom(stateAddon.DeepCopy())
 	if isParamPatchNeeded {
+		paramsMap := withoutParams(planAddonSpec.ParamsMap, planAddonSpec.IgnoreParams)
+		for _, key := range planAddonSpec.IgnoreParams {
+			if value, ok := stateParamsMap[key]; ok {
+				paramsMap[key] = value
+			}
+		}
 		var planAddonParams []sunpikev1alpha2.Params
-		for key, value := range planAddonSpec.ParamsMap {
+		for key, value := range paramsMap {
 			planAddonParams = append(planAddonParams, sunpikev1alpha2.Params{
 				Name:  key,
 				Value: value,
@@ -102,6 +232,85 @@ func (r *addonsClient) Patch(ctx context.Context,
 	return nil
 }
 
+// withoutParams returns a copy of params without the given keys
+func withoutParams(params map[string]string, keys []string) map[string]string {
+	filtered := map[string]string{}
+	for key, value := range params {
+		filtered[key] = value
+	}
+	for _, key := range keys {
+		delete(filtered, key)
+	}
+	return filtered
+}
+
+// redactParams returns a copy of params with the values of the sensitive keys redacted, for logging
+func redactParams(params map[string]string, sensitiveKeys []string) map[string]string {
+	redacted := withoutParams(params, nil)
+	for _, key := range sensitiveKeys {
+		if _, ok := redacted[key]; ok {
+			redacted[key] = redactedParamValue
+		}
+	}
+	return redacted
+}
+
+// maskSensitiveParams returns a context that masks the values of the sensitive params in every log line
+func maskSensitiveParams(ctx context.Context, sensitiveParams map[string]string) context.Context {
+	values := []string{}
+	for _, value := range sensitiveParams {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return ctx
+	}
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, values...)
+	return tflog.MaskMessageStrings(ctx, values...)
+}
+
+// hashSensitiveParams returns the salted hashes of the sensitive params, the state keeps them to detect
+// changes made outside of Terraform. The prior hash of a param is kept while it matches the value, so
+// that an unchanged param keeps its hash across plans.
+func hashSensitiveParams(sensitiveParams map[string]string, priorHashes map[string]string) (map[string]string, error) {
+	hashes := map[string]string{}
+	for key, value := range sensitiveParams {
+		if priorHash, found := priorHashes[key]; found && paramHashMatches(priorHash, value) {
+			hashes[key] = priorHash
+			continue
+		}
+		hash, err := hashParamValue(value)
+		if err != nil {
+			return nil, err
+		}
+		hashes[key] = hash
+	}
+	return hashes, nil
+}
+
+// hashParamValue returns the HMAC-SHA256 of the value keyed with a random salt, as <salt>:<hmac>,
+// so that the hash of a low entropy value cannot be looked up in a precomputed table
+func hashParamValue(value string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate a salt: %w", err)
+	}
+	return saltedParamHash(hex.EncodeToString(salt), value), nil
+}
+
+func saltedParamHash(salt string, value string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(value))
+	return salt + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// paramHashMatches reports whether the hash was computed from the value
+func paramHashMatches(hash string, value string) bool {
+	salt, _, found := strings.Cut(hash, ":")
+	return found && hmac.Equal([]byte(hash), []byte(saltedParamHash(salt, value)))
+}
+
 func convertParamsToMap(params []sunpikev1alpha2.Params) map[string]string {
 	paramsMap := map[string]string{}
 	for _, param := range params {
@@ -110,22 +319,44 @@ func convertParamsToMap(params []sunpikev1alpha2.Params) map[string]string {
 	return paramsMap
 }
 
-func (r *addonsClient) Get(ctx context.Context, addonName string) (sunpikev1alpha2.ClusterAddon, error) {
-	var sunpikeAddon sunpikev1alpha2.ClusterAddon
-	err := r.client.Get(ctx, types.NamespacedName{
-		Name:      addonName,
-		Namespace: "default",
-	}, &sunpikeAddon)
-	return sunpikeAddon, err
+// Get returns the addon that is not being deleted. An addon that was disabled recently stays around
+// with DeletionTimestamp set until it is uninstalled, it is not returned.
+func (r *addonsClient) Get(ctx context.Context, clusterID string, addonType string) (sunpikev1alpha2.ClusterAddon, error) {
+	clusterAddons, err := r.List(ctx, clusterID, addonType)
+	if err != nil {
+		return sunpikev1alpha2.ClusterAddon{}, err
+	}
+	activeAddons := getActiveAddons(clusterAddons)
+	switch len(activeAddons) {
+	case 0:
+		return sunpikev1alpha2.ClusterAddon{}, apierrors.NewNotFound(schema.GroupResource{Resource: "clusteraddons"},
+			r.config.addonName(clusterID, addonType))
+	case 1:
+		return activeAddons[0], nil
+	}
+	return sunpikev1alpha2.ClusterAddon{}, fmt.Errorf("found %v active instances of the addon %v on the cluster %v",
+		len(activeAddons), addonType, clusterID)
+}
+
+// getActiveAddons returns the addons that are not being deleted
+func getActiveAddons(clusterAddons []sunpikev1alpha2.ClusterAddon) []sunpikev1alpha2.ClusterAddon {
+	activeAddons := []sunpikev1alpha2.ClusterAddon{}
+	for _, clusterAddon := range clusterAddons {
+		if clusterAddon.DeletionTimestamp != nil {
+			continue
+		}
+		activeAddons = append(activeAddons, clusterAddon)
+	}
+	return activeAddons
 }
 
 func (r *addonsClient) List(ctx context.Context, clusterID string, addonType string) ([]sunpikev1alpha2.ClusterAddon, error) {
 	labelSelector := labels.SelectorFromSet(map[string]string{
-		"sunpike.pf9.io/cluster": clusterID,
-		"type":                   addonType,
+		addonClusterIDLabel: clusterID,
+		addonTypeLabel:      addonType,
 	})
 	listOptions := &client.ListOptions{
-		Namespace:     "default",
+		Namespace:     r.config.Namespace,
 		LabelSelector: labelSelector,
 	}
 	var clusterAddonsList sunpikev1alpha2.ClusterAddonList
@@ -137,33 +368,79 @@ func (r *addonsClient) List(ctx context.Context, clusterID string, addonType str
 }
 
 func (r *addonsClient) Disable(ctx context.Context, addonSpec AddonSpec) error {
-	// TODO: Use r.client.Get() assuming name = clusterID-addonType
-	clusterAddons, err := r.List(ctx, addonSpec.ClusterID, addonSpec.Type)
-	if err != nil {
-		return err
-	}
-	if len(clusterAddons) == 0 {
+	clusterAddon, err := r.Get(ctx, addonSpec.ClusterID, addonSpec.Type)
+	if apierrors.IsNotFound(err) {
 		tflog.Debug(ctx, "Addon is already disabled")
-		return nil
+	} else if err != nil {
+		return err
+	} else {
+		err = r.client.Delete(ctx, &clusterAddon)
+		if err != nil {
+			return err
+		}
 	}
-	clusterAddon := clusterAddons[0]
-	return r.client.Delete(ctx, &clusterAddon)
+	// The object stays around with DeletionTimestamp set until the addon is uninstalled
+	return r.waitForDeletion(ctx, addonSpec)
 }
 
-var addonAliases = map[string]string{
-	"kubevirt":             "kubevirtaddon",
-	"kubernetes-dashboard": "dashboard",
-	"metrics-server":       "metricsserver",
+func (r *addonsClient) waitForDeletion(ctx context.Context, addonSpec AddonSpec) error {
+	ctx, cancel := context.WithTimeout(ctx, getAddonDeleteTimeout(addonSpec))
+	defer cancel()
+	ticker := time.NewTicker(addonPollInterval)
+	defer ticker.Stop()
+	for {
+		clusterAddons, err := r.List(ctx, addonSpec.ClusterID, addonSpec.Type)
+		if err != nil {
+			return err
+		}
+		if len(clusterAddons) == 0 {
+			return nil
+		}
+		tflog.Debug(ctx, "Waiting for the addon to be removed", map[string]interface{}{
+			"addon": addonSpec.Type, "phase": clusterAddons[0].Status.Phase})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the addon %v to be removed, current phase: %v. Status message: %v",
+				addonSpec.Type, clusterAddons[0].Status.Phase, clusterAddons[0].Status.Message)
+		case <-ticker.C:
+		}
+	}
 }
 
-// APIs have different names for the same addon, hence this function
-// is workaround to get the default version for the addon
-func getDefaultAddonVersion(defaults map[string]string, addonType string) string {
-	if alias, ok := addonAliases[addonType]; ok {
-		addonType = alias
-	}
-	if version, ok := defaults[addonType]; ok {
-		return version
+func (r *addonsClient) WaitForInstall(ctx context.Context, addonSpec AddonSpec) (sunpikev1alpha2.ClusterAddon, error) {
+	timeout := getAddonInstallTimeout(addonSpec)
+	tflog.Debug(ctx, "Waiting for the addon to be installed", map[string]interface{}{"addon": addonSpec.Type, "timeout": timeout.String()})
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(addonPollInterval)
+	defer ticker.Stop()
+	lastPhase, lastMessage := "unknown", ""
+	for {
+		clusterAddon, err := r.Get(ctx, addonSpec.ClusterID, addonSpec.Type)
+		if apierrors.IsNotFound(err) {
+			return sunpikev1alpha2.ClusterAddon{}, fmt.Errorf("addon %v not found on the cluster %v", addonSpec.Type, addonSpec.ClusterID)
+		}
+		if err != nil {
+			return sunpikev1alpha2.ClusterAddon{}, err
+		}
+		phase := string(clusterAddon.Status.Phase)
+		lastPhase, lastMessage = phase, clusterAddon.Status.Message
+		// Until the controller has observed the latest spec, the status describes the previous one
+		if clusterAddon.Status.ObservedGeneration < clusterAddon.Generation {
+			tflog.Debug(ctx, "Waiting for the controller to pick up the addon changes", map[string]interface{}{
+				"addon": addonSpec.Type, "generation": clusterAddon.Generation, "observedGeneration": clusterAddon.Status.ObservedGeneration})
+		} else if phase == addonPhaseInstalled {
+			return clusterAddon, nil
+		} else if addonFailedPhases[phase] {
+			return clusterAddon, fmt.Errorf("addon %v failed with the phase %v: %v", addonSpec.Type, phase, clusterAddon.Status.Message)
+		} else {
+			tflog.Debug(ctx, "Addon is not installed yet", map[string]interface{}{"addon": addonSpec.Type, "phase": phase})
+		}
+		select {
+		case <-ctx.Done():
+			return sunpikev1alpha2.ClusterAddon{}, fmt.Errorf("timed out after %v waiting for the addon %v to be installed, current phase: %v. Status message: %v",
+				timeout, addonSpec.Type, lastPhase, lastMessage)
+		case <-ticker.C:
+		}
 	}
-	return ""
 }
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/platform9/pf9-sdk-go/pf9/pmk"
+	"github.com/platform9/pf9-sdk-go/pf9/qbert"
+)
+
+// normalizeAddonName returns the name in lower case without separators, e.g. metricsserver for metrics-server
+func normalizeAddonName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// matchAddonNames maps the qbert names of the addons to their types, for the names that are not a type.
+// qbert names some addons differently than the addon operator, e.g. metricsserver for metrics-server or
+// kubevirtaddon for kubevirt. A qbert name is matched with the type that has the same normalized name,
+// or else with the only type whose normalized name contains it or is contained in it. A name without
+// a match is left out.
+func matchAddonNames(qbertNames []string, addonTypes []string) map[string]string {
+	isType := map[string]bool{}
+	for _, addonType := range addonTypes {
+		isType[addonType] = true
+	}
+	matches := map[string]string{}
+	for _, qbertName := range qbertNames {
+		if isType[qbertName] {
+			continue
+		}
+		normalizedName := normalizeAddonName(qbertName)
+		var sameName, similarName []string
+		for _, addonType := range addonTypes {
+			normalizedType := normalizeAddonName(addonType)
+			switch {
+			case normalizedType == normalizedName:
+				sameName = append(sameName, addonType)
+			case strings.Contains(normalizedType, normalizedName) || strings.Contains(normalizedName, normalizedType):
+				similarName = append(similarName, addonType)
+			}
+		}
+		if len(sameName) == 1 {
+			matches[qbertName] = sameName[0]
+		} else if len(sameName) == 0 && len(similarName) == 1 {
+			matches[qbertName] = similarName[0]
+		}
+	}
+	return matches
+}
+
+// AddonCatalog holds the addons supported by a cluster with their default and supported versions,
+// keyed by the sunpike type of the addon, which is the name used by the addons map
+type AddonCatalog struct {
+	defaultVersions map[string]string
+	// versions are sorted in ascending order and include the default version
+	versions map[string][]string
+	// qbertNames maps the qbert names that differ from the type of their addon to the type
+	qbertNames map[string]string
+}
+
+// NewAddonCatalog builds the catalog from the versions reported by qbert. operatorVersions are keyed
+// by the type of the addon, defaultVersions and addonVersions by its qbert name, which is mapped to
+// the type with matchAddonNames. An addon that only qbert reports keeps its qbert name.
+func NewAddonCatalog(operatorVersions map[string][]string, defaultVersions map[string]string, addonVersions map[string][]string) AddonCatalog {
+	catalog := AddonCatalog{defaultVersions: map[string]string{}, versions: map[string][]string{}}
+	qbertNames := mapKeys(defaultVersions)
+	for qbertName := range addonVersions {
+		if _, ok := defaultVersions[qbertName]; !ok {
+			qbertNames = append(qbertNames, qbertName)
+		}
+	}
+	addonTypes := []string{}
+	for addonType := range operatorVersions {
+		addonTypes = append(addonTypes, addonType)
+	}
+	catalog.qbertNames = matchAddonNames(qbertNames, addonTypes)
+	for addonType, versions := range operatorVersions {
+		catalog.addVersions(addonType, versions...)
+	}
+	for qbertName, version := range defaultVersions {
+		addonType := catalog.addonType(qbertName)
+		catalog.defaultVersions[addonType] = version
+		catalog.addVersions(addonType, version)
+	}
+	for qbertName, versions := range addonVersions {
+		catalog.addVersions(catalog.addonType(qbertName), versions...)
+	}
+	for _, versions := range catalog.versions {
+		sortVersions(versions)
+	}
+	return catalog
+}
+
+// addonType returns the type of the addon that qbert names qbertName
+func (c AddonCatalog) addonType(qbertName string) string {
+	if addonType, ok := c.qbertNames[qbertName]; ok {
+		return addonType
+	}
+	return qbertName
+}
+
+// addVersions adds the addon to the catalog along with its versions, the empty ones are skipped
+func (c AddonCatalog) addVersions(addonType string, versions ...string) {
+	addonVersions := c.versions[addonType]
+	for _, version := range versions {
+		addonVersions = appendVersion(addonVersions, version)
+	}
+	c.versions[addonType] = addonVersions
+}
+
+// appendVersion appends the version unless it is empty or already in versions
+func appendVersion(versions []string, version string) []string {
+	if version == "" {
+		return versions
+	}
+	for _, v := range versions {
+		if v == version {
+			return versions
+		}
+	}
+	return append(versions, version)
+}
+
+// sortVersions sorts semantic versions in ascending order, other versions go first in lexical order
+func sortVersions(versions []string) {
+	sort.Slice(versions, func(i, j int) bool {
+		vi, erri := semver.NewVersion(versions[i])
+		vj, errj := semver.NewVersion(versions[j])
+		switch {
+		case erri != nil && errj != nil:
+			return versions[i] < versions[j]
+		case erri != nil || errj != nil:
+			return erri != nil
+		}
+		return vi.LessThan(vj)
+	})
+}
+
+// getAddonCatalog fetches the catalog of the addons supported by the cluster
+func getAddonCatalog(ctx context.Context, client *pmk.HTTPClient, qbertAPI *qbertAPIClient, clusterID string) (AddonCatalog, error) {
+	defaultVersions, err := client.Qbert().ListSupportedAddonVersions(ctx, clusterID)
+	if err != nil {
+		return AddonCatalog{}, fmt.Errorf("failed to get supported addon versions: %w", err)
+	}
+	addonVersions, err := qbertAPI.listAddonVersions(ctx, clusterID)
+	if err != nil {
+		return AddonCatalog{}, err
+	}
+	operatorVersions, err := qbertAPI.listAddonOperatorVersions(ctx, clusterID)
+	if err != nil {
+		return AddonCatalog{}, err
+	}
+	tflog.Debug(ctx, "Supported addon versions", map[string]interface{}{
+		"defaults": defaultVersions, "versions": addonVersions, "operatorVersions": operatorVersions})
+	return NewAddonCatalog(operatorVersions, defaultVersions, addonVersions), nil
+}
+
+// decodeAddonVersions decodes the response of the addonversions API, which maps each addon
+// to its version or to the list of its versions
+func decodeAddonVersions(body []byte) (map[string][]string, error) {
+	var rawVersions map[string]json.RawMessage
+	if err := json.Unmarshal(body, &rawVersions); err != nil {
+		return nil, fmt.Errorf("failed to decode addon versions: %w", err)
+	}
+	addonVersions := map[string][]string{}
+	for addonName, rawVersion := range rawVersions {
+		var version string
+		if err := json.Unmarshal(rawVersion, &version); err == nil {
+			addonVersions[addonName] = appendVersion(nil, version)
+			continue
+		}
+		var versions []string
+		if err := json.Unmarshal(rawVersion, &versions); err != nil {
+			return nil, fmt.Errorf("failed to decode the versions of the addon %v: %w", addonName, err)
+		}
+		addonVersions[addonName] = versions
+	}
+	return addonVersions, nil
+}
+
+// findProjectCluster returns the ID of a cluster of the project of the provider that runs the kube role
+// version, empty if there is none. Clusters of other tenants are skipped, as their addons may not be
+// readable with the credentials of the provider.
+func findProjectCluster(ctx context.Context, client *pmk.HTTPClient, kubeRoleVersion string) (string, error) {
+	authInfo, err := client.Authenticator().Auth(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to authenticate: %w", err)
+	}
+	clusters, err := client.Qbert().ListClusters(qbert.ListOptions{All: true})
+	if err != nil {
+		return "", err
+	}
+	clusterIDs := []string{}
+	for _, cluster := range clusters {
+		if cluster.ProjectID == authInfo.ProjectID && cluster.KubeRoleVersion == kubeRoleVersion {
+			clusterIDs = append(clusterIDs, cluster.UUID)
+		}
+	}
+	if len(clusterIDs) == 0 {
+		return "", nil
+	}
+	sort.Strings(clusterIDs)
+	tflog.Debug(ctx, "Reading supported addons from a cluster of the project", map[string]interface{}{
+		"kubeRoleVersion": kubeRoleVersion, "clusterID": clusterIDs[0]})
+	return clusterIDs[0], nil
+}
+
+// Contains reports whether the addon is supported by the cluster
+func (c AddonCatalog) Contains(addonType string) bool {
+	_, ok := c.versions[addonType]
+	return ok
+}
+
+// DefaultVersion returns the default version of the addon, empty if the addon is not supported
+func (c AddonCatalog) DefaultVersion(addonType string) string {
+	return c.defaultVersions[addonType]
+}
+
+// Versions returns the supported versions of the addon in ascending order
+func (c AddonCatalog) Versions(addonType string) []string {
+	return c.versions[addonType]
+}
+
+// Names returns the sunpike types of the supported addons, sorted
+func (c AddonCatalog) Names() []string {
+	names := []string{}
+	for addonType := range c.versions {
+		names = append(names, addonType)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// unknownAddonError returns the error detail for an addon that the cluster does not support,
+// with a hint for the qbert names of the addons
+func unknownAddonError(addonType string, cluster string, catalog AddonCatalog) string {
+	if sunpikeType, ok := catalog.qbertNames[addonType]; ok {
+		return fmt.Sprintf("%v is the qbert name of the addon, use its type %v instead", addonType, sunpikeType)
+	}
+	return fmt.Sprintf("The addon %v is not supported by %v. Valid addon names: %v",
+		addonType, cluster, strings.Join(catalog.Names(), ", "))
+}
+
+// latestAddonVersion is the version constraint that resolves to the highest supported version
+const latestAddonVersion = "latest"
+
+// parseAddonVersionConstraint parses a constraint such as ">= 0.6, < 0.8", nil for latest
+func parseAddonVersionConstraint(versionConstraint string) (*semver.Constraints, error) {
+	if versionConstraint == latestAddonVersion {
+		return nil, nil
+	}
+	constraint, err := semver.NewConstraint(versionConstraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version constraint %v: %w", versionConstraint, err)
+	}
+	return constraint, nil
+}
+
+// ResolveVersion returns the highest supported version of the addon that satisfies the constraint. The
+// installed version is a candidate too, as the cluster may no longer report it as supported.
+func (c AddonCatalog) ResolveVersion(addonType string, versionConstraint string, installedVersion string) (string, error) {
+	constraint, err := parseAddonVersionConstraint(versionConstraint)
+	if err != nil {
+		return "", err
+	}
+	candidates := appendVersion(append([]string{}, c.Versions(addonType)...), installedVersion)
+	var resolved *semver.Version
+	resolvedVersion := ""
+	for _, candidate := range candidates {
+		version, err := semver.NewVersion(candidate)
+		if err != nil {
+			continue
+		}
+		if constraint != nil && !constraint.Check(version) {
+			continue
+		}
+		if resolved == nil || version.GreaterThan(resolved) {
+			resolved = version
+			resolvedVersion = candidate
+		}
+	}
+	if resolved == nil {
+		supportedVersions := strings.Join(c.Versions(addonType), ", ")
+		if supportedVersions == "" {
+			supportedVersions = "none"
+		}
+		detail := fmt.Sprintf("no version of the addon %v satisfies %v, supported versions: %v",
+			addonType, versionConstraint, supportedVersions)
+		if installedVersion != "" {
+			detail += fmt.Sprintf(", installed version: %v", installedVersion)
+		}
+		return "", errors.New(detail)
+	}
+	return resolvedVersion, nil
+}
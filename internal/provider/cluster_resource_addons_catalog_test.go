@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatchAddonNames(t *testing.T) {
+	tests := []struct {
+		name       string
+		qbertNames []string
+		addonTypes []string
+		want       map[string]string
+	}{
+		{
+			name:       "qbert names of the addons",
+			qbertNames: []string{"kubevirtaddon", "dashboard", "metricsserver", "coredns"},
+			addonTypes: []string{"kubevirt", "kubernetes-dashboard", "metrics-server", "coredns"},
+			want: map[string]string{
+				"kubevirtaddon": "kubevirt",
+				"dashboard":     "kubernetes-dashboard",
+				"metricsserver": "metrics-server",
+			},
+		},
+		{
+			name:       "same name preferred over a similar one",
+			qbertNames: []string{"metricsserver"},
+			addonTypes: []string{"metrics-server", "metrics-server-operator"},
+			want:       map[string]string{"metricsserver": "metrics-server"},
+		},
+		{
+			name:       "ambiguous name",
+			qbertNames: []string{"dns"},
+			addonTypes: []string{"coredns", "kube-dns"},
+			want:       map[string]string{},
+		},
+		{
+			name:       "name without a match",
+			qbertNames: []string{"luigi"},
+			addonTypes: []string{"coredns"},
+			want:       map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchAddonNames(tt.qbertNames, tt.addonTypes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("matchAddonNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAddonCatalog(t *testing.T) {
+	catalog := NewAddonCatalog(
+		map[string][]string{"metrics-server": {"0.6.4", "0.10.0"}, "kubernetes-dashboard": {"2.7.0"}, "coredns": {"1.11.1"}},
+		map[string]string{"metricsserver": "0.6.4", "coredns": "1.11.1", "luigi": ""},
+		map[string][]string{"metricsserver": {"0.7.0", "0.6.4"}, "dashboard": {"2.7.0"}},
+	)
+	if got, want := catalog.Names(), []string{"coredns", "kubernetes-dashboard", "luigi", "metrics-server"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if !catalog.Contains("metrics-server") || catalog.Contains("metricsserver") {
+		t.Errorf("Contains() must match the sunpike type of the addon only")
+	}
+	if !catalog.Contains("luigi") || len(catalog.Versions("luigi")) != 0 {
+		t.Errorf("an addon without a version must be supported with no versions")
+	}
+	if got, want := catalog.Versions("metrics-server"), []string{"0.6.4", "0.7.0", "0.10.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Versions(metrics-server) = %v, want %v", got, want)
+	}
+	if got, want := catalog.Versions("coredns"), []string{"1.11.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Versions(coredns) = %v, want %v", got, want)
+	}
+	if got, want := catalog.DefaultVersion("metrics-server"), "0.6.4"; got != want {
+		t.Errorf("DefaultVersion(metrics-server) = %q, want %q", got, want)
+	}
+	if got := catalog.DefaultVersion("kubernetes-dashboard"); got != "" {
+		t.Errorf("DefaultVersion(kubernetes-dashboard) = %q, want empty", got)
+	}
+}
+
+func TestDecodeAddonVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    map[string][]string
+		wantErr string
+	}{
+		{
+			name: "single versions",
+			body: `{"coredns": "1.11.1", "metricsserver": ""}`,
+			want: map[string][]string{"coredns": {"1.11.1"}, "metricsserver": nil},
+		},
+		{
+			name: "lists of versions",
+			body: `{"kubevirtaddon": ["0.58.0", "0.59.0"]}`,
+			want: map[string][]string{"kubevirtaddon": {"0.58.0", "0.59.0"}},
+		},
+		{
+			name:    "invalid versions of an addon",
+			body:    `{"coredns": 1}`,
+			wantErr: "failed to decode the versions of the addon coredns",
+		},
+		{
+			name:    "invalid body",
+			body:    `["coredns"]`,
+			wantErr: "failed to decode addon versions",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeAddonVersions([]byte(tt.body))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("decodeAddonVersions() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeAddonVersions() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeAddonVersions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnknownAddonError(t *testing.T) {
+	catalog := NewAddonCatalog(
+		map[string][]string{"coredns": {"1.11.1"}, "metrics-server": {"0.6.4"}},
+		map[string]string{"coredns": "1.11.1", "metricsserver": "0.6.4"},
+		nil,
+	)
+	tests := []struct {
+		addonType string
+		want      string
+	}{
+		{
+			addonType: "metricsserver",
+			want:      "metricsserver is the qbert name of the addon, use its type metrics-server instead",
+		},
+		{
+			addonType: "luigi",
+			want:      "The addon luigi is not supported by the cluster. Valid addon names: coredns, metrics-server",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addonType, func(t *testing.T) {
+			if got := unknownAddonError(tt.addonType, "the cluster", catalog); got != tt.want {
+				t.Errorf("unknownAddonError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	catalog := NewAddonCatalog(
+		map[string][]string{"metrics-server": {"0.6.4", "0.7.0", "0.10.0"}, "coredns": {}},
+		map[string]string{"metricsserver": "0.6.4"},
+		nil,
+	)
+	tests := []struct {
+		name             string
+		addonType        string
+		constraint       string
+		installedVersion string
+		want             string
+		wantErr          string
+	}{
+		{
+			name:       "latest",
+			addonType:  "metrics-server",
+			constraint: "latest",
+			want:       "0.10.0",
+		},
+		{
+			name:       "exact version",
+			addonType:  "metrics-server",
+			constraint: "0.7.0",
+			want:       "0.7.0",
+		},
+		{
+			name:       "range",
+			addonType:  "metrics-server",
+			constraint: ">= 0.6, < 0.8",
+			want:       "0.7.0",
+		},
+		{
+			name:             "installed version that is no longer supported",
+			addonType:        "metrics-server",
+			constraint:       "< 0.6",
+			installedVersion: "0.5.2",
+			want:             "0.5.2",
+		},
+		{
+			name:             "supported version higher than the installed one",
+			addonType:        "metrics-server",
+			constraint:       "latest",
+			installedVersion: "0.6.4",
+			want:             "0.10.0",
+		},
+		{
+			name:             "no version satisfies the constraint",
+			addonType:        "metrics-server",
+			constraint:       "> 1.0",
+			installedVersion: "0.6.4",
+			wantErr: "no version of the addon metrics-server satisfies > 1.0, supported versions: 0.6.4, 0.7.0, 0.10.0, " +
+				"installed version: 0.6.4",
+		},
+		{
+			name:       "addon without versions",
+			addonType:  "coredns",
+			constraint: "latest",
+			wantErr:    "no version of the addon coredns satisfies latest, supported versions: none",
+		},
+		{
+			name:       "invalid constraint",
+			addonType:  "metrics-server",
+			constraint: "foo",
+			wantErr:    "invalid version constraint foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := catalog.ResolveVersion(tt.addonType, tt.constraint, tt.installedVersion)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ResolveVersion() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveVersion() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
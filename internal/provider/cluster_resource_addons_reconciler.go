@@ -0,0 +1,727 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/platform9/terraform-provider-pf9/internal/provider/resource_cluster"
+
+	sunpikev1alpha2 "github.com/platform9/pf9-sdk-go/pf9/apis/sunpike/v1alpha2"
+)
+
+// defaultAddonConcurrency is the number of addon actions applied at the same time unless the
+// provider configures addon_concurrency
+const defaultAddonConcurrency = 4
+
+// DesiredAddon is the state of an addon requested in the plan
+type DesiredAddon struct {
+	Enabled bool
+	// Version is empty when the plan does not know it yet
+	Version string
+	// VersionConstraint is resolved to Version against the addon catalog when Version is empty
+	VersionConstraint string
+	// ParamsMap is nil when the params are unknown in the plan
+	ParamsMap map[string]string
+	// ParamsOverrides are the params set through the typed block of the addon,
+	// they take precedence over ParamsMap
+	ParamsOverrides map[string]string
+	// IgnoreParams are excluded from the comparison with the observed params
+	IgnoreParams []string
+	// SensitiveParams are merged into the params at apply time and redacted in logs
+	SensitiveParams map[string]string
+}
+
+type AddonActionType string
+
+const (
+	AddonActionDisable AddonActionType = "disable"
+	AddonActionPatch   AddonActionType = "patch"
+	AddonActionEnable  AddonActionType = "enable"
+)
+
+// AddonAction is a single step required to move the observed addons to the desired state
+type AddonAction struct {
+	Action AddonActionType
+	Spec   AddonSpec
+	// Observed is the remote addon that the action is applied to, nil for enable
+	Observed *sunpikev1alpha2.ClusterAddon
+}
+
+func (a AddonAction) String() string {
+	switch a.Action {
+	case AddonActionEnable:
+		if a.Spec.Version == "" {
+			return fmt.Sprintf("enable %v (default version)", a.Spec.Type)
+		}
+		return fmt.Sprintf("enable %v (version %v)", a.Spec.Type, a.Spec.Version)
+	case AddonActionPatch:
+		changes := []string{}
+		if a.Observed.Spec.Version != a.Spec.Version {
+			changes = append(changes, fmt.Sprintf("version %v -> %v", a.Observed.Spec.Version, a.Spec.Version))
+		}
+		if !StrMap(withoutParams(a.Spec.ParamsMap, a.Spec.IgnoreParams)).Equals(
+			withoutParams(convertParamsToMap(a.Observed.Spec.Override.Params), a.Spec.IgnoreParams)) {
+			changes = append(changes, "params changed")
+		}
+		return fmt.Sprintf("patch %v (%v)", a.Spec.Type, strings.Join(changes, ", "))
+	default:
+		return fmt.Sprintf("%v %v", a.Action, a.Spec.Type)
+	}
+}
+
+// planAddonActions compares the desired addons with the observed ones and returns the actions
+// needed to reconcile them. Addons that are observed but not desired are disabled. Disables come
+// first so that resources are released before new addons are installed, then patches, then enables.
+// An addon without a desired version gets the default version of the catalog, an enabled addon
+// keeps its version only if the catalog has none. An enable action has an empty version if neither
+// the plan nor the catalog provide one.
+func planAddonActions(clusterID string, desired map[string]DesiredAddon, observed []sunpikev1alpha2.ClusterAddon,
+	catalog AddonCatalog) []AddonAction {
+	observedMap := map[string]sunpikev1alpha2.ClusterAddon{}
+	for _, addon := range observed {
+		observedMap[addon.Spec.Type] = addon
+	}
+	var disables, patches, enables []AddonAction
+	for addonName, desiredAddon := range desired {
+		observedAddon, found := observedMap[addonName]
+		switch {
+		case !desiredAddon.Enabled && found:
+			disables = append(disables, AddonAction{
+				Action:   AddonActionDisable,
+				Spec:     AddonSpec{ClusterID: clusterID, Type: addonName},
+				Observed: &observedAddon,
+			})
+		case desiredAddon.Enabled && found:
+			spec := AddonSpec{
+				ClusterID:    clusterID,
+				Type:         addonName,
+				Version:      desiredAddon.Version,
+				ParamsMap:    desiredAddon.ParamsMap,
+				IgnoreParams: desiredAddon.IgnoreParams,
+			}
+			if spec.Version == "" {
+				spec.Version = catalog.DefaultVersion(addonName)
+			}
+			if spec.Version == "" {
+				spec.Version = observedAddon.Spec.Version
+			}
+			observedParams := convertParamsToMap(observedAddon.Spec.Override.Params)
+			if spec.ParamsMap == nil {
+				spec.ParamsMap = observedParams
+			}
+			spec.ParamsMap = mergeParams(spec.ParamsMap, desiredAddon.SensitiveParams, desiredAddon.ParamsOverrides)
+			spec.SensitiveParams = mapKeys(desiredAddon.SensitiveParams)
+			if spec.Version != observedAddon.Spec.Version ||
+				!StrMap(withoutParams(spec.ParamsMap, spec.IgnoreParams)).Equals(withoutParams(observedParams, spec.IgnoreParams)) {
+				patches = append(patches, AddonAction{
+					Action:   AddonActionPatch,
+					Spec:     spec,
+					Observed: &observedAddon,
+				})
+			}
+		case desiredAddon.Enabled && !found:
+			spec := AddonSpec{
+				ClusterID: clusterID,
+				Type:      addonName,
+				Version:   desiredAddon.Version,
+				ParamsMap: desiredAddon.ParamsMap,
+			}
+			if spec.Version == "" {
+				spec.Version = catalog.DefaultVersion(addonName)
+			}
+			spec.ParamsMap = mergeParams(spec.ParamsMap, desiredAddon.SensitiveParams, desiredAddon.ParamsOverrides)
+			spec.SensitiveParams = mapKeys(desiredAddon.SensitiveParams)
+			enables = append(enables, AddonAction{
+				Action: AddonActionEnable,
+				Spec:   spec,
+			})
+		}
+	}
+	for addonName := range observedMap {
+		if _, found := desired[addonName]; !found {
+			observedAddon := observedMap[addonName]
+			disables = append(disables, AddonAction{
+				Action:   AddonActionDisable,
+				Spec:     AddonSpec{ClusterID: clusterID, Type: addonName},
+				Observed: &observedAddon,
+			})
+		}
+	}
+	actions := []AddonAction{}
+	for _, group := range [][]AddonAction{disables, patches, enables} {
+		sort.Slice(group, func(i, j int) bool { return group[i].Spec.Type < group[j].Spec.Type })
+		actions = append(actions, group...)
+	}
+	return actions
+}
+
+// mergeParams returns a copy of base with each of overrides applied on top of it in order
+func mergeParams(base map[string]string, overrides ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for key, value := range base {
+		merged[key] = value
+	}
+	for _, override := range overrides {
+		for key, value := range override {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getDesiredAddons converts the addons map of the plan to the reconciler input.
+// An addon set to null or with enabled=false is desired to be disabled.
+func getDesiredAddons(ctx context.Context, planAddons types.Map) (map[string]DesiredAddon, diag.Diagnostics) {
+	desired := map[string]DesiredAddon{}
+	tfAddonsMap := map[string]resource_cluster.AddonsValue{}
+	diags := planAddons.ElementsAs(ctx, &tfAddonsMap, false)
+	if diags.HasError() {
+		return desired, diags
+	}
+	for addonName, tfAddon := range tfAddonsMap {
+		if tfAddon.IsNull() || (!tfAddon.Enabled.IsNull() && !tfAddon.Enabled.IsUnknown() && !tfAddon.Enabled.ValueBool()) {
+			desired[addonName] = DesiredAddon{Enabled: false}
+			continue
+		}
+		desiredAddon := DesiredAddon{Enabled: true}
+		if !tfAddon.VersionConstraint.IsNull() && !tfAddon.VersionConstraint.IsUnknown() {
+			desiredAddon.VersionConstraint = tfAddon.VersionConstraint.ValueString()
+		}
+		if !tfAddon.Version.IsNull() && !tfAddon.Version.IsUnknown() {
+			desiredAddon.Version = tfAddon.Version.ValueString()
+		}
+		if !tfAddon.Params.IsUnknown() {
+			tfParamsInPlan := map[string]types.String{}
+			diags.Append(tfAddon.Params.ElementsAs(ctx, &tfParamsInPlan, false)...)
+			if diags.HasError() {
+				return desired, diags
+			}
+			desiredAddon.ParamsMap = map[string]string{}
+			for key, value := range tfParamsInPlan {
+				// TODO: Decide how to handle null values. Currently they are sent as empty strings.
+				desiredAddon.ParamsMap[key] = value.ValueString()
+			}
+		}
+		desiredAddon.ParamsOverrides = getTypedAddonParams(tfAddon, addonName)
+		ignoreParams, ignoreDiags := getIgnoreParams(ctx, tfAddon.IgnoreParams)
+		diags.Append(ignoreDiags...)
+		desiredAddon.IgnoreParams = ignoreParams
+		sensitiveParams, sensitiveDiags := getSensitiveParams(ctx, tfAddon.SensitiveParams)
+		diags.Append(sensitiveDiags...)
+		desiredAddon.SensitiveParams = sensitiveParams
+		if diags.HasError() {
+			return desired, diags
+		}
+		desired[addonName] = desiredAddon
+	}
+	return desired, diags
+}
+
+// getIgnoreParams returns the names of the ignored params, nil if ignore_params is not set
+func getIgnoreParams(ctx context.Context, ignoreParams types.Set) ([]string, diag.Diagnostics) {
+	if ignoreParams.IsNull() || ignoreParams.IsUnknown() {
+		return nil, nil
+	}
+	keys := []string{}
+	diags := ignoreParams.ElementsAs(ctx, &keys, false)
+	return keys, diags
+}
+
+// getSensitiveParams returns the sensitive params, nil if sensitive_params is not set
+func getSensitiveParams(ctx context.Context, sensitiveParams types.Map) (map[string]string, diag.Diagnostics) {
+	if sensitiveParams.IsNull() || sensitiveParams.IsUnknown() {
+		return nil, nil
+	}
+	params := map[string]string{}
+	diags := sensitiveParams.ElementsAs(ctx, &params, false)
+	return params, diags
+}
+
+// validateSensitiveAddonParams checks that the sensitive params are not set in params,
+// in the typed block or in ignore_params
+func validateSensitiveAddonParams(ctx context.Context, addonName string, tfAddon resource_cluster.AddonsValue) diag.Diagnostics {
+	// only the keys are compared, values may be unknown during validation
+	sensitiveParams := tfAddon.SensitiveParams.Elements()
+	if len(sensitiveParams) == 0 {
+		return nil
+	}
+	ignoreParams, diags := getIgnoreParams(ctx, tfAddon.IgnoreParams)
+	if diags.HasError() {
+		return diags
+	}
+	setParams := map[string]string{}
+	for key := range getTypedAddonParams(tfAddon, addonName) {
+		setParams[key] = getTypedAddonBlockName(addonName)
+	}
+	for _, key := range ignoreParams {
+		setParams[key] = "ignore_params"
+	}
+	if !tfAddon.Params.IsNull() && !tfAddon.Params.IsUnknown() {
+		rawParams := map[string]types.String{}
+		diags.Append(tfAddon.Params.ElementsAs(ctx, &rawParams, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		for key := range rawParams {
+			setParams[key] = "params"
+		}
+	}
+	for key := range sensitiveParams {
+		if attrName, ok := setParams[key]; ok {
+			diags.AddAttributeError(path.Root("addons").AtMapKey(addonName).AtName("sensitive_params"), "Conflicting addon params",
+				fmt.Sprintf("%v is a sensitive param, it cannot be set in %v", key, attrName))
+		}
+	}
+	return diags
+}
+
+// validateIgnoredAddonParams checks that the ignored params are not set in params or in the typed block
+func validateIgnoredAddonParams(ctx context.Context, addonName string, tfAddon resource_cluster.AddonsValue) diag.Diagnostics {
+	ignoreParams, diags := getIgnoreParams(ctx, tfAddon.IgnoreParams)
+	if diags.HasError() || len(ignoreParams) == 0 {
+		return diags
+	}
+	setParams := map[string]bool{}
+	for key := range getTypedAddonParams(tfAddon, addonName) {
+		setParams[key] = true
+	}
+	if !tfAddon.Params.IsNull() && !tfAddon.Params.IsUnknown() {
+		rawParams := map[string]types.String{}
+		diags.Append(tfAddon.Params.ElementsAs(ctx, &rawParams, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		for key := range rawParams {
+			setParams[key] = true
+		}
+	}
+	for _, key := range ignoreParams {
+		if setParams[key] {
+			diags.AddAttributeError(path.Root("addons").AtMapKey(addonName).AtName("ignore_params"), "Conflicting addon params",
+				fmt.Sprintf("%v is ignored, it cannot be set for the addon %v", key, addonName))
+		}
+	}
+	return diags
+}
+
+// resolveAddonVersionConstraints sets the version of the desired addons that have a version
+// constraint and no version yet
+func resolveAddonVersionConstraints(desired map[string]DesiredAddon, observed []sunpikev1alpha2.ClusterAddon, catalog AddonCatalog) diag.Diagnostics {
+	var diags diag.Diagnostics
+	installedVersions := map[string]string{}
+	for _, observedAddon := range observed {
+		installedVersions[observedAddon.Spec.Type] = observedAddon.Spec.Version
+	}
+	for addonName, desiredAddon := range desired {
+		if !desiredAddon.Enabled || desiredAddon.VersionConstraint == "" || desiredAddon.Version != "" {
+			continue
+		}
+		version, err := catalog.ResolveVersion(addonName, desiredAddon.VersionConstraint, installedVersions[addonName])
+		if err != nil {
+			diags.AddAttributeError(path.Root("addons").AtMapKey(addonName).AtName("version_constraint"),
+				"Failed to resolve addon version", err.Error())
+			continue
+		}
+		desiredAddon.Version = version
+		desired[addonName] = desiredAddon
+	}
+	return diags
+}
+
+// reconcileClusterAddons makes the addons of the cluster match the addons map of the plan. ModifyPlan
+// records the versions it planned in the plan, so the actions are the ones shown in the plan unless the
+// addons were changed outside of Terraform in between. Versions are looked up here only for a new cluster
+// whose kube role version no cluster of the project runs, as its catalog is not known at plan time.
+// timeout bounds the wait for each addon, the default of the addon type applies when zero.
+func (r *clusterResource) reconcileClusterAddons(ctx context.Context, clusterID string, planAddons types.Map, timeout time.Duration) diag.Diagnostics {
+	desired, diags := getDesiredAddons(ctx, planAddons)
+	if diags.HasError() {
+		return diags
+	}
+	for _, desiredAddon := range desired {
+		ctx = maskSensitiveParams(ctx, desiredAddon.SensitiveParams)
+	}
+	tflog.Debug(ctx, "Getting list of enabled addons")
+	observed, err := r.listClusterAddons(ctx, clusterID)
+	if err != nil {
+		diags.AddError("Failed to get cluster addons", err.Error())
+		return diags
+	}
+	catalog, err := getAddonCatalog(ctx, r.client, r.qbertAPI, clusterID)
+	if err != nil {
+		diags.AddError("Failed to get default addon versions", err.Error())
+		return diags
+	}
+	diags.Append(resolveAddonVersionConstraints(desired, observed, catalog)...)
+	if diags.HasError() {
+		return diags
+	}
+	actions := planAddonActions(clusterID, desired, observed, catalog)
+	for i := range actions {
+		actions[i].Spec.Timeout = timeout
+	}
+	// Disables, patches and enables are applied in that order, the actions of the same kind
+	// touch different addons and are applied concurrently. A failed addon does not stop the
+	// other addons, the apply runs every group and reports the errors of every failed addon.
+	// Only the later actions of an addon whose earlier action failed are skipped.
+	failed := map[string]bool{}
+	for _, actionType := range []AddonActionType{AddonActionDisable, AddonActionPatch, AddonActionEnable} {
+		var group []AddonAction
+		for _, action := range actions {
+			if action.Action != actionType {
+				continue
+			}
+			if failed[action.Spec.Type] {
+				tflog.Info(ctx, "Skipping addon action after a failed action of the same addon", map[string]interface{}{"action": action.String()})
+				continue
+			}
+			group = append(group, action)
+		}
+		diags.Append(r.applyAddonActions(ctx, group, failed)...)
+	}
+	return diags
+}
+
+// applyAddonActions applies the actions with at most addonConcurrency of them running at once,
+// records the addons whose action failed in failed and returns the diagnostics of all of them
+func (r *clusterResource) applyAddonActions(ctx context.Context, actions []AddonAction, failed map[string]bool) diag.Diagnostics {
+	concurrency := r.addonConcurrency
+	if concurrency < 1 {
+		concurrency = defaultAddonConcurrency
+	}
+	results := make([]diag.Diagnostics, len(actions))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, action := range actions {
+		wg.Add(1)
+		go func(i int, action AddonAction) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			results[i] = r.applyAddonAction(ctx, action)
+		}(i, action)
+	}
+	wg.Wait()
+	var diags diag.Diagnostics
+	for i, result := range results {
+		if result.HasError() {
+			failed[actions[i].Spec.Type] = true
+		}
+		diags.Append(result...)
+	}
+	return diags
+}
+
+func (r *clusterResource) applyAddonAction(ctx context.Context, action AddonAction) diag.Diagnostics {
+	var diags diag.Diagnostics
+	addonPath := path.Root("addons").AtMapKey(action.Spec.Type)
+	tflog.Info(ctx, "Applying addon action", map[string]interface{}{"action": action.String()})
+	switch action.Action {
+	case AddonActionDisable:
+		err := r.addonsClient.Disable(ctx, action.Spec)
+		if err != nil {
+			diags.AddAttributeError(addonPath, "Failed to disable addon", err.Error())
+		}
+		return diags
+	case AddonActionPatch:
+		err := r.addonsClient.Patch(ctx, action.Spec, action.Observed)
+		if err != nil {
+			diags.AddAttributeError(addonPath, "Failed to patch addon", err.Error())
+			return diags
+		}
+	case AddonActionEnable:
+		if action.Spec.Version == "" {
+			diags.AddAttributeError(addonPath,
+				"Failed to get addon version", fmt.Sprintf("Unrecognized addon name %s or missing version in the API response", action.Spec.Type))
+			return diags
+		}
+		err := r.addonsClient.Enable(ctx, action.Spec)
+		if err != nil {
+			diags.AddAttributeError(addonPath, "Failed to enable addon", err.Error())
+			return diags
+		}
+	}
+	_, err := r.addonsClient.WaitForInstall(ctx, action.Spec)
+	if err != nil {
+		diags.AddAttributeError(addonPath, "Addon installation did not complete", err.Error())
+	}
+	return diags
+}
+
+// modifyPlanSensitiveParamsHash plans the hashes of the sensitive params, so that a change
+// of a sensitive param shows up as a change of its hash
+func modifyPlanSensitiveParamsHash(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planAddons types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("addons"), &planAddons)...)
+	if resp.Diagnostics.HasError() || planAddons.IsNull() || planAddons.IsUnknown() {
+		return
+	}
+	tfAddonsMap := map[string]resource_cluster.AddonsValue{}
+	resp.Diagnostics.Append(planAddons.ElementsAs(ctx, &tfAddonsMap, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateAddonsMap := map[string]resource_cluster.AddonsValue{}
+	if !req.State.Raw.IsNull() {
+		var stateAddons types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("addons"), &stateAddons)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !stateAddons.IsNull() && !stateAddons.IsUnknown() {
+			resp.Diagnostics.Append(stateAddons.ElementsAs(ctx, &stateAddonsMap, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	for addonName, tfAddon := range tfAddonsMap {
+		if tfAddon.IsNull() || tfAddon.IsUnknown() || tfAddon.SensitiveParams.IsUnknown() {
+			continue
+		}
+		hashPath := path.Root("addons").AtMapKey(addonName).AtName("sensitive_params_hash")
+		if tfAddon.SensitiveParams.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, hashPath, types.MapNull(types.StringType))...)
+			continue
+		}
+		sensitiveParams, diags := getSensitiveParams(ctx, tfAddon.SensitiveParams)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		priorHashes := map[string]string{}
+		if stateAddon, found := stateAddonsMap[addonName]; found && !stateAddon.IsNull() && !stateAddon.SensitiveParamsHash.IsNull() {
+			resp.Diagnostics.Append(stateAddon.SensitiveParamsHash.ElementsAs(ctx, &priorHashes, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		hashes, err := hashSensitiveParams(sensitiveParams, priorHashes)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(addonName).AtName("sensitive_params"),
+				"Failed to hash the sensitive params", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, hashPath, hashes)...)
+	}
+}
+
+// modifyPlanAddons shows the addon changes of the plan as a warning and sets the versions that
+// will be installed for the addons whose version is not specified. A new cluster that omits addons
+// is planned with the default addons of its kube role version. The catalog of a new cluster is read
+// from a cluster of the project that runs the same kube role version, without such a cluster the
+// addons and the default versions are not known until apply.
+func (r *clusterResource) modifyPlanAddons(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planAddons types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("addons"), &planAddons)...)
+	if resp.Diagnostics.HasError() || planAddons.IsNull() {
+		return
+	}
+	var kubeRoleVersion string
+	var catalog AddonCatalog
+	var catalogFound bool
+	if req.State.Raw.IsNull() {
+		kubeRoleVersion, catalog, catalogFound = r.getKubeRoleVersionCatalog(ctx, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planAddons.IsUnknown() && catalogFound {
+			tflog.Debug(ctx, "addons is not provided in the plan; defaulting to the default addons",
+				map[string]interface{}{"kube_role_version": kubeRoleVersion})
+			var diags diag.Diagnostics
+			planAddons, diags = defaultAddons(ctx, catalog)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("addons"), planAddons)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	if planAddons.IsUnknown() {
+		return
+	}
+	desired, diags := getDesiredAddons(ctx, planAddons)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var clusterID string
+	var observed []sunpikev1alpha2.ClusterAddon
+	if !req.State.Raw.IsNull() {
+		var stateID types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &stateID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clusterID = stateID.ValueString()
+		var err error
+		observed, err = r.listClusterAddons(ctx, clusterID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get cluster addons", err.Error())
+			return
+		}
+		catalog, err = getAddonCatalog(ctx, r.client, r.qbertAPI, clusterID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get default addon versions", err.Error())
+			return
+		}
+		observedNames := map[string]bool{}
+		for _, observedAddon := range observed {
+			observedNames[observedAddon.Spec.Type] = true
+		}
+		for addonName, desiredAddon := range desired {
+			if desiredAddon.Enabled && !observedNames[addonName] && !catalog.Contains(addonName) {
+				resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(addonName), "Unknown addon",
+					unknownAddonError(addonName, "the cluster "+clusterID, catalog))
+			}
+		}
+		for addonName, desiredAddon := range desired {
+			// The version of a constrained addon is carried over from the state, resolve it again
+			if desiredAddon.VersionConstraint != "" {
+				desiredAddon.Version = ""
+				desired[addonName] = desiredAddon
+			}
+		}
+		resp.Diagnostics.Append(resolveAddonVersionConstraints(desired, observed, catalog)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if catalogFound {
+		checkNewClusterAddonNames(desired, kubeRoleVersion, catalog, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	actions := planAddonActions(clusterID, desired, observed, catalog)
+
+	plannedVersions := map[string]string{}
+	for _, observedAddon := range observed {
+		plannedVersions[observedAddon.Spec.Type] = observedAddon.Spec.Version
+	}
+	changes := []string{}
+	for _, action := range actions {
+		changes = append(changes, "- "+action.String())
+		if action.Action == AddonActionEnable && action.Spec.Version == "" && !req.State.Raw.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(action.Spec.Type),
+				"Failed to get addon version", fmt.Sprintf("Unrecognized addon name %s or missing version in the API response", action.Spec.Type))
+			return
+		}
+		plannedVersions[action.Spec.Type] = action.Spec.Version
+	}
+	for addonName, desiredAddon := range desired {
+		hasConstraint := desiredAddon.VersionConstraint != ""
+		if !desiredAddon.Enabled || (desiredAddon.Version != "" && !hasConstraint) || plannedVersions[addonName] == "" {
+			continue
+		}
+		var version types.String
+		versionPath := path.Root("addons").AtMapKey(addonName).AtName("version")
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, versionPath, &version)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The version of a constrained addon is carried over from the state, replace it with the resolved one
+		if version.IsUnknown() || (hasConstraint && version.ValueString() != plannedVersions[addonName]) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, versionPath, plannedVersions[addonName])...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	if len(changes) > 0 {
+		resp.Diagnostics.AddWarning("Addon changes",
+			fmt.Sprintf("The following addon changes will be applied:\n%v", strings.Join(changes, "\n")))
+	}
+}
+
+// getKubeRoleVersionCatalog returns the planned kube role version of a new cluster and the catalog of a
+// cluster of the project that runs it. found is false if the version is not known yet or no cluster of
+// the project runs it.
+func (r *clusterResource) getKubeRoleVersionCatalog(ctx context.Context, resp *resource.ModifyPlanResponse) (string, AddonCatalog, bool) {
+	var kubeRoleVersion types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("kube_role_version"), &kubeRoleVersion)...)
+	if resp.Diagnostics.HasError() || kubeRoleVersion.IsNull() || kubeRoleVersion.IsUnknown() {
+		return "", AddonCatalog{}, false
+	}
+	referenceClusterID, err := findProjectCluster(ctx, r.client, kubeRoleVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list clusters", err.Error())
+		return "", AddonCatalog{}, false
+	}
+	if referenceClusterID == "" {
+		return kubeRoleVersion.ValueString(), AddonCatalog{}, false
+	}
+	catalog, err := getAddonCatalog(ctx, r.client, r.qbertAPI, referenceClusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get supported addon versions", err.Error())
+		return "", AddonCatalog{}, false
+	}
+	return kubeRoleVersion.ValueString(), catalog, true
+}
+
+// checkNewClusterAddonNames checks the addon names of a new cluster against the catalog of its kube role version
+func checkNewClusterAddonNames(desired map[string]DesiredAddon, kubeRoleVersion string, catalog AddonCatalog, resp *resource.ModifyPlanResponse) {
+	for addonName, desiredAddon := range desired {
+		if !desiredAddon.Enabled || catalog.Contains(addonName) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("addons").AtMapKey(addonName), "Unknown addon",
+			unknownAddonError(addonName, "the kube role version "+kubeRoleVersion, catalog))
+	}
+}
+
+// defaultAddons returns the addons map of a new cluster that omits addons, i.e. every addon of the
+// catalog that has a default version, enabled at that version. The params are known after apply,
+// they are the default params of the backend.
+func defaultAddons(ctx context.Context, catalog AddonCatalog) (types.Map, diag.Diagnostics) {
+	addonsType := resource_cluster.AddonsValue{}.Type(ctx)
+	attributeTypes := resource_cluster.AddonsValue{}.AttributeTypes(ctx)
+	tfAddonsMap := map[string]resource_cluster.AddonsValue{}
+	for _, addonName := range catalog.Names() {
+		version := catalog.DefaultVersion(addonName)
+		if version == "" {
+			continue
+		}
+		// The values are set directly, ToObjectValue would turn the unknown params into an empty map
+		addonVal, diags := resource_cluster.NewAddonsValue(attributeTypes, map[string]attr.Value{
+			"enabled":               types.BoolValue(true),
+			"version":               types.StringValue(version),
+			"version_constraint":    types.StringNull(),
+			"phase":                 types.StringUnknown(),
+			"params":                types.MapUnknown(types.StringType),
+			"ignore_params":         types.SetNull(types.StringType),
+			"sensitive_params":      types.MapNull(types.StringType),
+			"sensitive_params_hash": types.MapNull(types.StringType),
+			"coredns":               types.ObjectNull(resource_cluster.CorednsValue{}.AttributeTypes(ctx)),
+			"metallb":               types.ObjectNull(resource_cluster.MetallbValue{}.AttributeTypes(ctx)),
+			"metrics_server":        types.ObjectNull(resource_cluster.MetricsServerValue{}.AttributeTypes(ctx)),
+			"monitoring":            types.ObjectNull(resource_cluster.MonitoringValue{}.AttributeTypes(ctx)),
+		})
+		if diags.HasError() {
+			return types.MapNull(addonsType), diags
+		}
+		tfAddonsMap[addonName] = addonVal
+	}
+	return types.MapValueFrom(ctx, addonsType, tfAddonsMap)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	sunpikev1alpha2 "github.com/platform9/pf9-sdk-go/pf9/apis/sunpike/v1alpha2"
+)
+
+func testClusterAddon(addonType string, version string, params map[string]string) sunpikev1alpha2.ClusterAddon {
+	addon := sunpikev1alpha2.ClusterAddon{}
+	addon.Spec.Type = addonType
+	addon.Spec.Version = version
+	for _, key := range mapKeys(params) {
+		addon.Spec.Override.Params = append(addon.Spec.Override.Params, sunpikev1alpha2.Params{Name: key, Value: params[key]})
+	}
+	return addon
+}
+
+type testAddonAction struct {
+	Action  AddonActionType
+	Type    string
+	Version string
+	Params  map[string]string
+}
+
+func TestPlanAddonActions(t *testing.T) {
+	catalog := NewAddonCatalog(
+		map[string][]string{"coredns": {"1.10.1", "1.11.1"}, "metrics-server": {"0.6.4", "0.7.0"}},
+		map[string]string{"coredns": "1.11.1", "metricsserver": "0.6.4"},
+		nil,
+	)
+	tests := []struct {
+		name     string
+		desired  map[string]DesiredAddon
+		observed []sunpikev1alpha2.ClusterAddon
+		want     []testAddonAction
+	}{
+		{
+			name:    "enable with the default version of the catalog",
+			desired: map[string]DesiredAddon{"coredns": {Enabled: true, ParamsMap: map[string]string{"dnsMemoryLimit": "170Mi"}}},
+			want: []testAddonAction{
+				{Action: AddonActionEnable, Type: "coredns", Version: "1.11.1", Params: map[string]string{"dnsMemoryLimit": "170Mi"}},
+			},
+		},
+		{
+			name:    "enable with the desired version",
+			desired: map[string]DesiredAddon{"metrics-server": {Enabled: true, Version: "0.7.0"}},
+			want: []testAddonAction{
+				{Action: AddonActionEnable, Type: "metrics-server", Version: "0.7.0", Params: map[string]string{}},
+			},
+		},
+		{
+			name:    "enable without a version in the plan or the catalog",
+			desired: map[string]DesiredAddon{"luigi": {Enabled: true}},
+			want: []testAddonAction{
+				{Action: AddonActionEnable, Type: "luigi", Version: "", Params: map[string]string{}},
+			},
+		},
+		{
+			name:     "no action when the observed addon matches",
+			desired:  map[string]DesiredAddon{"coredns": {Enabled: true, Version: "1.11.1", ParamsMap: map[string]string{"a": "1"}}},
+			observed: []sunpikev1alpha2.ClusterAddon{testClusterAddon("coredns", "1.11.1", map[string]string{"a": "1"})},
+			want:     []testAddonAction{},
+		},
+		{
+			name:     "patch the version",
+			desired:  map[string]DesiredAddon{"coredns": {Enabled: true, Version: "1.11.1"}},
+			observed: []sunpikev1alpha2.ClusterAddon{testClusterAddon("coredns", "1.10.1", map[string]string{"a": "1"})},
+			want: []testAddonAction{
+				{Action: AddonActionPatch, Type: "coredns", Version: "1.11.1", Params: map[string]string{"a": "1"}},
+			},
+		},
+		{
+			name:     "patch the params",
+			desired:  map[string]DesiredAddon{"coredns": {Enabled: true, Version: "1.10.1", ParamsMap: map[string]string{"a": "2"}}},
+			observed: []sunpikev1alpha2.ClusterAddon{testClusterAddon("coredns", "1.10.1", map[string]string{"a": "1"})},
+			want: []testAddonAction{
+				{Action: AddonActionPatch, Type: "coredns", Version: "1.10.1", Params: map[string]string{"a": "2"}},
+			},
+		},
+		{
+			name: "typed block and sensitive params are merged into the params",
+			desired: map[string]DesiredAddon{"coredns": {Enabled: true, Version: "1.10.1",
+				ParamsMap:       map[string]string{"a": "1", "b": "1"},
+				ParamsOverrides: map[string]string{"b": "2"},
+				SensitiveParams: map[string]string{"secret": "s"}}},
+			observed: []sunpikev1alpha2.ClusterAddon{testClusterAddon("coredns", "1.10.1", map[string]string{"a": "1", "b": "1"})},
+			want: []testAddonAction{
+				{Action: AddonActionPatch, Type: "coredns", Version: "1.10.1", Params: map[string]string{"a": "1", "b": "2", "secret": "s"}},
+			},
+		},
+		{
+			name: "ignored params are not compared",
+			desired: map[string]DesiredAddon{"coredns": {Enabled: true, Version: "1.10.1",
+				ParamsMap: map[string]string{"a": "1"}, IgnoreParams: []string{"b"}}},
+			observed: []sunpikev1alpha2.ClusterAddon{testClusterAddon("coredns", "1.10.1", map[string]string{"a": "1", "b": "1"})},
+			want:     []testAddonAction{},
+		},
+		{
+			name:     "unknown params and version keep the observed ones",
+			desired:  map[string]DesiredAddon{"luigi": {Enabled: true}},
+			observed: []sunpikev1alpha2.ClusterAddon{testClusterAddon("luigi", "0.5.0", map[string]string{"a": "1"})},
+			want:     []testAddonAction{},
+		},
+		{
+			name:     "disable a disabled addon",
+			desired:  map[string]DesiredAddon{"coredns": {Enabled: false}},
+			observed: []sunpikev1alpha2.ClusterAddon{testClusterAddon("coredns", "1.10.1", nil)},
+			want:     []testAddonAction{{Action: AddonActionDisable, Type: "coredns"}},
+		},
+		{
+			name:    "disabled addon that is not observed",
+			desired: map[string]DesiredAddon{"coredns": {Enabled: false}},
+			want:    []testAddonAction{},
+		},
+		{
+			name: "disable addons that are not listed",
+			observed: []sunpikev1alpha2.ClusterAddon{
+				testClusterAddon("coredns", "1.10.1", nil),
+				testClusterAddon("metrics-server", "0.6.4", nil),
+			},
+			want: []testAddonAction{
+				{Action: AddonActionDisable, Type: "coredns"},
+				{Action: AddonActionDisable, Type: "metrics-server"},
+			},
+		},
+		{
+			name: "disables, then patches, then enables",
+			desired: map[string]DesiredAddon{
+				"metallb":        {Enabled: true, Version: "0.13.0"},
+				"coredns":        {Enabled: true, Version: "1.11.1"},
+				"metrics-server": {Enabled: false},
+				"kubevirt":       {Enabled: true, Version: "0.58.0"},
+			},
+			observed: []sunpikev1alpha2.ClusterAddon{
+				testClusterAddon("coredns", "1.10.1", nil),
+				testClusterAddon("metrics-server", "0.6.4", nil),
+				testClusterAddon("luigi", "0.5.0", nil),
+			},
+			want: []testAddonAction{
+				{Action: AddonActionDisable, Type: "luigi"},
+				{Action: AddonActionDisable, Type: "metrics-server"},
+				{Action: AddonActionPatch, Type: "coredns", Version: "1.11.1", Params: map[string]string{}},
+				{Action: AddonActionEnable, Type: "kubevirt", Version: "0.58.0", Params: map[string]string{}},
+				{Action: AddonActionEnable, Type: "metallb", Version: "0.13.0", Params: map[string]string{}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := planAddonActions("cluster-id", tt.desired, tt.observed, catalog)
+			got := []testAddonAction{}
+			for _, action := range actions {
+				if action.Spec.ClusterID != "cluster-id" {
+					t.Errorf("planAddonActions() cluster ID = %q, want cluster-id", action.Spec.ClusterID)
+				}
+				if (action.Action == AddonActionEnable) != (action.Observed == nil) {
+					t.Errorf("planAddonActions() %v has observed addon %v", action, action.Observed)
+				}
+				got = append(got, testAddonAction{
+					Action:  action.Action,
+					Type:    action.Spec.Type,
+					Version: action.Spec.Version,
+					Params:  action.Spec.ParamsMap,
+				})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planAddonActions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultAddons(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewAddonCatalog(
+		map[string][]string{"coredns": {"1.11.1"}, "metrics-server": {"0.6.4"}, "metallb": {"0.13.0"}},
+		map[string]string{"coredns": "1.11.1", "metricsserver": "0.6.4"},
+		nil,
+	)
+	addons, diags := defaultAddons(ctx, catalog)
+	if diags.HasError() {
+		t.Fatalf("defaultAddons() diagnostics = %v", diags)
+	}
+	desired, diags := getDesiredAddons(ctx, addons)
+	if diags.HasError() {
+		t.Fatalf("getDesiredAddons() diagnostics = %v", diags)
+	}
+	got := map[string]string{}
+	for addonName, desiredAddon := range desired {
+		if !desiredAddon.Enabled {
+			t.Errorf("defaultAddons() %v is not enabled", addonName)
+		}
+		if desiredAddon.ParamsMap != nil {
+			t.Errorf("defaultAddons() %v params = %v, want unknown", addonName, desiredAddon.ParamsMap)
+		}
+		got[addonName] = desiredAddon.Version
+	}
+	want := map[string]string{"coredns": "1.11.1", "metrics-server": "0.6.4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("defaultAddons() versions = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	sunpikev1alpha2 "github.com/platform9/pf9-sdk-go/pf9/apis/sunpike/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetActiveAddons(t *testing.T) {
+	deletedAt := metav1.Now()
+	terminating := testClusterAddon("coredns", "1.11.1", nil)
+	terminating.Name = "terminating"
+	terminating.DeletionTimestamp = &deletedAt
+	active := testClusterAddon("coredns", "1.11.1", nil)
+	active.Name = "active"
+
+	tests := []struct {
+		name   string
+		addons []sunpikev1alpha2.ClusterAddon
+		want   []string
+	}{
+		{
+			name: "no addons",
+			want: []string{},
+		},
+		{
+			name:   "terminating addon is skipped",
+			addons: []sunpikev1alpha2.ClusterAddon{terminating, active},
+			want:   []string{"active"},
+		},
+		{
+			name:   "only terminating addons",
+			addons: []sunpikev1alpha2.ClusterAddon{terminating},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, addon := range getActiveAddons(tt.addons) {
+				got = append(got, addon.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getActiveAddons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
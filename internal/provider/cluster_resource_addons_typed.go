@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/platform9/terraform-provider-pf9/internal/provider/resource_cluster"
+)
+
+// typedAddonParamKeys maps the attributes of the typed addon blocks to the names of the
+// params sent to sunpike, keyed by the addon type the block belongs to
+var typedAddonParamKeys = map[string]map[string]string{
+	"coredns": {
+		"dns_memory_limit":  "dnsMemoryLimit",
+		"dns_domain":        "dnsDomain",
+		"min_replicas":      "MinReplicas",
+		"max_replicas":      "MaxReplicas",
+		"cores_per_replica": "CoresPerReplica",
+		"nodes_per_replica": "NodesPerReplica",
+		"poll_period_secs":  "PollPeriodSecs",
+	},
+	"metrics-server": {
+		"cpu_limit":    "metricsCpuLimit",
+		"memory_limit": "metricsMemoryLimit",
+	},
+	"monitoring": {
+		"retention_time":     "retentionTime",
+		"storage_class_name": "storageClassName",
+		"pvc_size":           "pvcSize",
+	},
+	"metallb": {
+		"ip_range": "MetallbIpRange",
+	},
+}
+
+// getTypedAddonBlocks returns the typed blocks of the addon keyed by the addon type they belong to
+func getTypedAddonBlocks(tfAddon resource_cluster.AddonsValue) map[string]basetypes.ObjectValue {
+	return map[string]basetypes.ObjectValue{
+		"coredns":        tfAddon.Coredns,
+		"metallb":        tfAddon.Metallb,
+		"metrics-server": tfAddon.MetricsServer,
+		"monitoring":     tfAddon.Monitoring,
+	}
+}
+
+func setTypedAddonBlock(tfAddon *resource_cluster.AddonsValue, addonName string, block basetypes.ObjectValue) {
+	switch addonName {
+	case "coredns":
+		tfAddon.Coredns = block
+	case "metallb":
+		tfAddon.Metallb = block
+	case "metrics-server":
+		tfAddon.MetricsServer = block
+	case "monitoring":
+		tfAddon.Monitoring = block
+	}
+}
+
+// getTypedAddonBlockName returns the name of the attribute holding the typed block of the addon
+func getTypedAddonBlockName(addonName string) string {
+	return strings.ReplaceAll(addonName, "-", "_")
+}
+
+// getTypedAddonParams returns the params set through the typed block of the addon, nil if the block is not set
+func getTypedAddonParams(tfAddon resource_cluster.AddonsValue, addonName string) map[string]string {
+	block, ok := getTypedAddonBlocks(tfAddon)[addonName]
+	if !ok || block.IsNull() || block.IsUnknown() {
+		return nil
+	}
+	params := map[string]string{}
+	for attrName, value := range block.Attributes() {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		paramName := typedAddonParamKeys[addonName][attrName]
+		switch v := value.(type) {
+		case types.String:
+			params[paramName] = v.ValueString()
+		case types.Int64:
+			params[paramName] = strconv.FormatInt(v.ValueInt64(), 10)
+		}
+	}
+	return params
+}
+
+// typedAddonBlockFromParams builds the typed block from the remote params. Only the attributes
+// set in planBlock are read from the params, the rest stay null to keep the state same as plan.
+func typedAddonBlockFromParams(ctx context.Context, addonName string, planBlock basetypes.ObjectValue, params map[string]string) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attrTypes := planBlock.AttributeTypes(ctx)
+	planAttrs := planBlock.Attributes()
+	attrs := map[string]attr.Value{}
+	for attrName, attrType := range attrTypes {
+		paramValue, found := params[typedAddonParamKeys[addonName][attrName]]
+		isSetInPlan := planAttrs[attrName] != nil && !planAttrs[attrName].IsNull()
+		if attrType.Equal(types.Int64Type) {
+			attrs[attrName] = types.Int64Null()
+			if found && isSetInPlan {
+				intValue, err := strconv.ParseInt(paramValue, 10, 64)
+				if err != nil {
+					diags.AddError("Failed to parse addon param",
+						fmt.Sprintf("Param %v of the addon %v is not an integer: %v", typedAddonParamKeys[addonName][attrName], addonName, paramValue))
+					return planBlock, diags
+				}
+				attrs[attrName] = types.Int64Value(intValue)
+			}
+			continue
+		}
+		attrs[attrName] = types.StringNull()
+		if found && isSetInPlan {
+			attrs[attrName] = types.StringValue(paramValue)
+		}
+	}
+	return types.ObjectValue(attrTypes, attrs)
+}
+
+// validateTypedAddonBlocks checks that the typed blocks are used only with their addon and do not
+// conflict with the raw params
+func validateTypedAddonBlocks(ctx context.Context, addonName string, tfAddon resource_cluster.AddonsValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+	addonPath := path.Root("addons").AtMapKey(addonName)
+	for blockAddonName, block := range getTypedAddonBlocks(tfAddon) {
+		if block.IsNull() || block.IsUnknown() || blockAddonName == addonName {
+			continue
+		}
+		diags.AddAttributeError(addonPath.AtName(getTypedAddonBlockName(blockAddonName)), "Invalid addon configuration",
+			fmt.Sprintf("%v can only be set for the %v addon", getTypedAddonBlockName(blockAddonName), blockAddonName))
+	}
+	typedParams := getTypedAddonParams(tfAddon, addonName)
+	if len(typedParams) == 0 {
+		return diags
+	}
+	if !tfAddon.Params.IsNull() && !tfAddon.Params.IsUnknown() {
+		rawParams := map[string]types.String{}
+		diags.Append(tfAddon.Params.ElementsAs(ctx, &rawParams, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		for attrName, paramName := range typedAddonParamKeys[addonName] {
+			if _, inTyped := typedParams[paramName]; !inTyped {
+				continue
+			}
+			if _, inRaw := rawParams[paramName]; inRaw {
+				diags.AddAttributeError(addonPath.AtName("params").AtMapKey(paramName), "Conflicting addon params",
+					fmt.Sprintf("%v is already set by %v.%v, remove it from params", paramName, getTypedAddonBlockName(addonName), attrName))
+			}
+		}
+	}
+	if ipRange, ok := typedParams["MetallbIpRange"]; ok {
+		if err := validateIPRanges(ipRange); err != nil {
+			diags.AddAttributeError(addonPath.AtName("metallb").AtName("ip_range"), "Invalid IP range", err.Error())
+		}
+	}
+	return diags
+}
+
+// validateIPRanges validates a comma separated list of CIDRs or start-end IP ranges
+func validateIPRanges(ipRanges string) error {
+	for _, ipRange := range strings.Split(ipRanges, ",") {
+		ipRange = strings.TrimSpace(ipRange)
+		if strings.Contains(ipRange, "/") {
+			if _, _, err := net.ParseCIDR(ipRange); err != nil {
+				return fmt.Errorf("invalid CIDR %v: %w", ipRange, err)
+			}
+			continue
+		}
+		bounds := strings.Split(ipRange, "-")
+		if len(bounds) != 2 {
+			return fmt.Errorf("invalid IP range %v, expected <start>-<end>", ipRange)
+		}
+		start, end := net.ParseIP(bounds[0]), net.ParseIP(bounds[1])
+		if start == nil || end == nil {
+			return fmt.Errorf("invalid IP address in the range %v", ipRange)
+		}
+		if (start.To4() == nil) != (end.To4() == nil) {
+			return fmt.Errorf("IP range %v mixes IPv4 and IPv6 addresses", ipRange)
+		}
+		if bytes.Compare(start.To16(), end.To16()) > 0 {
+			return fmt.Errorf("start of the IP range %v is after its end", ipRange)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/platform9/pf9-sdk-go/pf9/qbert"
+	"github.com/platform9/pf9-sdk-go/pf9/resmgr"
+)
+
+func TestGetClusterAPIEndpoint(t *testing.T) {
+	apiServer := Cluster{}
+	apiServer.Cluster.Server = "https://10.0.0.10:443"
+	tests := []struct {
+		name         string
+		qbertCluster qbert.Cluster
+		want         string
+	}{
+		{
+			name:         "external DNS name",
+			qbertCluster: qbert.Cluster{ExternalDNSName: "mycluster.example.com", MasterIP: "10.0.0.10", K8sAPIPort: "6443"},
+			want:         "https://mycluster.example.com:6443",
+		},
+		{
+			name:         "master IP",
+			qbertCluster: qbert.Cluster{MasterIP: "10.0.0.10", K8sAPIPort: "6443"},
+			want:         "https://10.0.0.10:6443",
+		},
+		{
+			name:         "IPv6 master IP",
+			qbertCluster: qbert.Cluster{MasterIP: "fd00::10", K8sAPIPort: "443"},
+			want:         "https://[fd00::10]:443",
+		},
+		{
+			name:         "default port",
+			qbertCluster: qbert.Cluster{ExternalDNSName: "mycluster.example.com"},
+			want:         "https://mycluster.example.com:443",
+		},
+		{
+			name:         "server of the kubeconfig without a host",
+			qbertCluster: qbert.Cluster{K8sAPIPort: "6443"},
+			want:         "https://10.0.0.10:443",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getClusterAPIEndpoint(&tt.qbertCluster, apiServer); got != tt.want {
+				t.Errorf("getClusterAPIEndpoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHostAttachProblems(t *testing.T) {
+	eligibleHost := func() resmgr.Host {
+		host := resmgr.Host{Roles: []string{"pf9-kube"}, RoleStatus: "ok"}
+		host.Info.Responding = true
+		host.Info.OSInfo = "Ubuntu 22.04.3 Jammy Jellyfish"
+		host.Info.Arch = "x86_64"
+		return host
+	}
+	tests := []struct {
+		name   string
+		modify func(host *resmgr.Host)
+		want   []string
+	}{
+		{
+			name:   "eligible host",
+			modify: func(host *resmgr.Host) {},
+			want:   []string{},
+		},
+		{
+			name:   "not responding",
+			modify: func(host *resmgr.Host) { host.Info.Responding = false },
+			want:   []string{"node n1 is not responding"},
+		},
+		{
+			name:   "conflicting role",
+			modify: func(host *resmgr.Host) { host.Roles = append(host.Roles, "pf9-ostackhost-neutron") },
+			want:   []string{"node n1 has a conflicting role pf9-ostackhost-neutron"},
+		},
+		{
+			name:   "without the kube role",
+			modify: func(host *resmgr.Host) { host.Roles = []string{} },
+			want:   []string{"node n1 (Ubuntu 22.04.3 Jammy Jellyfish, x86_64) does not have the pf9-kube role"},
+		},
+		{
+			name: "kube role failed on an unsupported platform",
+			modify: func(host *resmgr.Host) {
+				host.Info.OSInfo = "Debian 12"
+				host.Info.Arch = "aarch64"
+				host.RoleStatus = "failed"
+				host.Message = "unsupported distribution"
+			},
+			want: []string{"the pf9-kube role of node n1 (Debian 12, aarch64) is in the failed state: unsupported distribution"},
+		},
+		{
+			name: "every problem is reported",
+			modify: func(host *resmgr.Host) {
+				host.Info.Responding = false
+				host.RoleStatus = "converging"
+			},
+			want: []string{
+				"node n1 is not responding",
+				"the pf9-kube role of node n1 (Ubuntu 22.04.3 Jammy Jellyfish, x86_64) is in the converging state",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := eligibleHost()
+			tt.modify(&host)
+			if got := getHostAttachProblems("n1", host); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getHostAttachProblems() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,9 +2,8 @@ package provider
 
 import (
 	"context"
-	"regexp"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +37,7 @@ func (d *clustersDataSource) Configure(ctx context.Context, req datasource.Confi
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -52,11 +51,22 @@ func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	var filters []datasource_clusters.FiltersValue
-	resp.Diagnostics.Append(data.Filters.ElementsAs(ctx, &filters, false)...)
+	var filterValues []datasource_clusters.FiltersValue
+	resp.Diagnostics.Append(data.Filters.ElementsAs(ctx, &filterValues, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	filters := []Filter{}
+	isTenantFilterSet := false
+	for _, filterValue := range filterValues {
+		filter, diags := newFilter(ctx, filterValue.Name, filterValue.Values, filterValue.Regexes, filterValue.Operator)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		filters = append(filters, filter)
+		isTenantFilterSet = isTenantFilterSet || filter.Name == "tenant"
+	}
 	clusters, err := d.client.Qbert().ListClusters(qbert.ListOptions{
 		All: true,
 	})
@@ -64,44 +74,80 @@ func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		resp.Diagnostics.AddError("Failed to list clusters", err.Error())
 		return
 	}
-
-	// Iterate through clusters and remove clusters not matching to the filter
-	for _, filter := range filters {
-		attribName := filter.Name.ValueString()
-		var values []string
-		resp.Diagnostics.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+	// Tenant names are read from keystone only when they are filtered on
+	tenantNames := map[string]string{}
+	if isTenantFilterSet {
+		tenantNames, diags = d.getTenantNames(ctx)
+		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		var regexes []string
-		if len(values) == 0 {
-			resp.Diagnostics.Append(filter.Regexes.ElementsAs(ctx, &regexes, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
+	}
+	clusters, err = applyFilters(clusters, filters, clusterFilterAttributes(tenantNames))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to filter clusters", err.Error())
+		return
+	}
+
+	// Node counts are read in one pass over the nodes of the tenant
+	authInfo, err := d.client.Authenticator().Auth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to authenticate", err.Error())
+		return
+	}
+	nodes, err := d.client.Qbert().ListNodes(authInfo.ProjectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list nodes", err.Error())
+		return
+	}
+	masterNodesCount := map[string]int64{}
+	workerNodesCount := map[string]int64{}
+	for _, node := range nodes {
+		if node.ClusterUUID == "" {
+			continue
 		}
-		switch {
-		case attribName == "name":
-			clusters = filterClusters(clusters, attribName, values, regexes)
-		case attribName == "tenant":
-			clusters, diags = d.filterClustersByTenantName(ctx, clusters, values, regexes)
-			if diags.HasError() {
-				resp.Diagnostics.Append(diags...)
-				return
-			}
-		case strings.HasPrefix(attribName, "tags:"):
-			clusters = filterClustersByTags(clusters, attribName, values)
-		case attribName == "tenant_id":
-			clusters = filterClusters(clusters, attribName, values, regexes)
-		default:
-			resp.Diagnostics.AddError("Unknown filter", "Unknown filter: "+attribName)
-			return
+		if node.IsMaster == 1 {
+			masterNodesCount[node.ClusterUUID]++
+		} else {
+			workerNodesCount[node.ClusterUUID]++
 		}
 	}
 
 	var clusterIDs []string
+	clustersValues := []datasource_clusters.ClustersValue{}
 	for _, cluster := range clusters {
 		clusterIDs = append(clusterIDs, cluster.UUID)
+		tags, diags := types.MapValueFrom(ctx, types.StringType, cluster.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		mastersCount, workersCount := types.Int64Null(), types.Int64Null()
+		if cluster.ProjectID == authInfo.ProjectID {
+			mastersCount = types.Int64Value(masterNodesCount[cluster.UUID])
+			workersCount = types.Int64Value(workerNodesCount[cluster.UUID])
+		}
+		clustersValue, diags := datasource_clusters.NewClustersValue(datasource_clusters.ClustersValue{}.AttributeTypes(ctx),
+			map[string]attr.Value{
+				"id":                 types.StringValue(cluster.UUID),
+				"name":               types.StringValue(cluster.Name),
+				"project_id":         types.StringValue(cluster.ProjectID),
+				"kube_role_version":  types.StringValue(getClusterKubeRoleVersion(cluster)),
+				"status":             getStrOrNullIfEmpty(cluster.Status),
+				"network_plugin":     getStrOrNullIfEmpty(cluster.NetworkPlugin),
+				"can_upgrade":        types.BoolValue(cluster.CanUpgrade),
+				"node_pool_uuid":     getStrOrNullIfEmpty(cluster.NodePoolUUID),
+				"node_pool_name":     getStrOrNullIfEmpty(cluster.NodePoolName),
+				"master_nodes_count": mastersCount,
+				"worker_nodes_count": workersCount,
+				"created_at":         getStrOrNullIfEmpty(cluster.CreatedAt),
+				"tags":               tags,
+			})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clustersValues = append(clustersValues, clustersValue)
 	}
 
 	data.ClusterIds, diags = types.ListValueFrom(ctx, types.StringType, clusterIDs)
@@ -109,12 +155,18 @@ func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.Clusters, diags = types.ListValueFrom(ctx, datasource_clusters.ClustersValue{}.Type(ctx), clustersValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (d *clustersDataSource) filterClustersByTenantName(ctx context.Context, clusters []qbert.Cluster, values, regexes []string) ([]qbert.Cluster, diag.Diagnostics) {
+// getTenantNames returns the names of the tenants keyed by their IDs
+func (d *clustersDataSource) getTenantNames(ctx context.Context) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	authInfo, err := d.client.Authenticator().Auth(ctx)
 	if err != nil {
@@ -126,71 +178,44 @@ func (d *clustersDataSource) filterClustersByTenantName(ctx context.Context, clu
 		diags.AddError("Failed to list project", err.Error())
 		return nil, diags
 	}
-	tenantIDToClusterMap := map[string]qbert.Cluster{}
-	for _, cluster := range clusters {
-		tenantIDToClusterMap[cluster.ProjectID] = cluster
-	}
-
-	var filteredClusters []qbert.Cluster
+	tenantNames := map[string]string{}
 	for _, project := range projects {
-		for _, value := range values {
-			if project.Name == value {
-				if cluster, found := tenantIDToClusterMap[project.ID]; found {
-					filteredClusters = append(filteredClusters, cluster)
-				}
-			}
-		}
-		for _, regex := range regexes {
-			if ok, _ := regexp.Match(regex, []byte(project.Name)); ok {
-				if cluster, found := tenantIDToClusterMap[project.ID]; found {
-					filteredClusters = append(filteredClusters, cluster)
-				}
-			}
-		}
+		tenantNames[project.ID] = project.Name
 	}
-	return filteredClusters, diags
+	return tenantNames, diags
 }
 
-func filterClusters(clusters []qbert.Cluster, attribName string, values, regexes []string) []qbert.Cluster {
-	var filteredClusters []qbert.Cluster
-	for _, cluster := range clusters {
-		var valueFromCluster string
-		switch attribName {
-		case "name":
-			valueFromCluster = cluster.Name
-		case "tenant_id":
-			valueFromCluster = cluster.ProjectID
-		}
-		for _, value := range values {
-			if valueFromCluster == value {
-				filteredClusters = append(filteredClusters, cluster)
-			}
-		}
-		for _, regex := range regexes {
-			if ok, _ := regexp.Match(regex, []byte(valueFromCluster)); ok {
-				filteredClusters = append(filteredClusters, cluster)
-			}
-		}
+// clusterFilterAttributes returns the attributes the clusters can be filtered on, tenantNames
+// maps the tenant IDs to names for the tenant filter
+func clusterFilterAttributes(tenantNames map[string]string) filterAttributes[qbert.Cluster] {
+	return filterAttributes[qbert.Cluster]{
+		attributes: map[string]filterAttribute[qbert.Cluster]{
+			"name":              func(cluster qbert.Cluster) []string { return strAttr(cluster.Name) },
+			"tenant_id":         func(cluster qbert.Cluster) []string { return strAttr(cluster.ProjectID) },
+			"tenant":            func(cluster qbert.Cluster) []string { return strAttr(tenantNames[cluster.ProjectID]) },
+			"status":            func(cluster qbert.Cluster) []string { return strAttr(cluster.Status) },
+			"kube_role_version": func(cluster qbert.Cluster) []string { return strAttr(getClusterKubeRoleVersion(cluster)) },
+			"network_plugin":    func(cluster qbert.Cluster) []string { return strAttr(cluster.NetworkPlugin) },
+			"can_upgrade":       func(cluster qbert.Cluster) []string { return boolAttr(cluster.CanUpgrade) },
+			"node_pool_name":    func(cluster qbert.Cluster) []string { return strAttr(cluster.NodePoolName) },
+			"node_pool_uuid":    func(cluster qbert.Cluster) []string { return strAttr(cluster.NodePoolUUID) },
+		},
+		keyed: map[string]func(cluster qbert.Cluster, key string) []string{
+			"tags": func(cluster qbert.Cluster, key string) []string {
+				if value, ok := cluster.Tags[key]; ok {
+					return strAttr(value)
+				}
+				return nil
+			},
+		},
 	}
-	return filteredClusters
 }
 
-func filterClustersByTags(clusters []qbert.Cluster, attribName string, values []string) []qbert.Cluster {
-	var filteredClusters []qbert.Cluster
-	// tagKey = "environment"
-	tagKey := strings.TrimPrefix(attribName, "tags:")
-	if tagKey == "" {
-		return filteredClusters
-	}
-	for _, cluster := range clusters {
-		// values = ["production", "development"]
-		for _, value := range values {
-			if cluster.Tags[tagKey] == value {
-				// this cluster has tag "environment" with value "production" or "development"
-				filteredClusters = append(filteredClusters, cluster)
-				break
-			}
-		}
+// getClusterKubeRoleVersion returns the kube role version of the cluster. KubeRoleVersion does not
+// change immediately after the cluster upgrade, the target version is used while upgrading.
+func getClusterKubeRoleVersion(cluster qbert.Cluster) string {
+	if cluster.UpgradingTo != "" {
+		return cluster.UpgradingTo
 	}
-	return filteredClusters
+	return cluster.KubeRoleVersion
 }
@@ -5,8 +5,11 @@ package datasource_cluster
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -48,6 +51,11 @@ func ClusterDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "If the master nodes can run non-critical workloads",
 				MarkdownDescription: "If the master nodes can run non-critical workloads",
 			},
+			"api_endpoint": schema.StringAttribute{
+				Computed:            true,
+				Description:         "URL of the Kubernetes API server, built from external_dns_name, or master_ip when it is not set, and k8s_api_port. Use it to configure the kubernetes and helm providers",
+				MarkdownDescription: "URL of the Kubernetes API server, built from external_dns_name, or master_ip when it is not set, and k8s_api_port. Use it to configure the kubernetes and helm providers",
+			},
 			"batch_upgrade_percent": schema.Int64Attribute{
 				Computed:            true,
 				Description:         "Percentage of nodes to upgrade at a time during a batch upgrade. If this attribute is omitted then nodes will be sequentially upgraded, one after the other.",
@@ -158,6 +166,11 @@ func ClusterDataSourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Base64 encoded CA certificate of the Kubernetes API server, read from the kubeconfig of the cluster. Use base64decode() to configure the kubernetes and helm providers",
+				MarkdownDescription: "Base64 encoded CA certificate of the Kubernetes API server, read from the kubeconfig of the cluster. Use base64decode() to configure the kubernetes and helm providers",
+			},
 			"container_runtime": schema.StringAttribute{
 				Computed:            true,
 				Description:         "Container runtime used by this cluster",
@@ -329,9 +342,13 @@ func ClusterDataSourceSchema(ctx context.Context) schema.Schema {
 				Computed: true,
 			},
 			"id": schema.StringAttribute{
-				Required:            true,
-				Description:         "UUID of the cluster",
-				MarkdownDescription: "UUID of the cluster",
+				Optional:            true,
+				Computed:            true,
+				Description:         "UUID of the cluster. Exactly one of id and name must be set",
+				MarkdownDescription: "UUID of the cluster. Exactly one of id and name must be set",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"interface_detection_method": schema.StringAttribute{
 				Computed:            true,
@@ -430,9 +447,10 @@ func ClusterDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "MTU for container network interfaces. Optional and used for the Calico network backend",
 			},
 			"name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "Name of the cluster",
-				MarkdownDescription: "Name of the cluster",
+				Description:         "Name of the cluster. Looks up the cluster by name if id is not set",
+				MarkdownDescription: "Name of the cluster. Looks up the cluster by name if id is not set",
 			},
 			"network_plugin": schema.StringAttribute{
 				Computed:            true,
@@ -533,6 +551,7 @@ func ClusterDataSourceSchema(ctx context.Context) schema.Schema {
 type ClusterModel struct {
 	Addons                     types.Map           `tfsdk:"addons"`
 	AllowWorkloadsOnMaster     types.Bool          `tfsdk:"allow_workloads_on_master"`
+	ApiEndpoint                types.String        `tfsdk:"api_endpoint"`
 	BatchUpgradePercent        types.Int64         `tfsdk:"batch_upgrade_percent"`
 	CalicoIpIpMode             types.String        `tfsdk:"calico_ip_ip_mode"`
 	CalicoIpv4                 types.String        `tfsdk:"calico_ipv4"`
@@ -548,6 +567,7 @@ type ClusterModel struct {
 	CalicoV4BlockSize          types.String        `tfsdk:"calico_v4_block_size"`
 	CertExpiryHrs              types.Int64         `tfsdk:"cert_expiry_hrs"`
 	CloudProvider              CloudProviderValue  `tfsdk:"cloud_provider"`
+	ClusterCaCertificate       types.String        `tfsdk:"cluster_ca_certificate"`
 	ContainerRuntime           types.String        `tfsdk:"container_runtime"`
 	ContainersCidr             types.String        `tfsdk:"containers_cidr"`
 	CpuManagerPolicy           types.String        `tfsdk:"cpu_manager_policy"`
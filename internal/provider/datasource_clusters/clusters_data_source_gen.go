@@ -5,8 +5,10 @@ package datasource_clusters
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -24,13 +26,101 @@ func ClustersDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "A list of cluster IDs for clusters that match all the specified filters.",
 				MarkdownDescription: "A list of cluster IDs for clusters that match all the specified filters.",
 			},
+			"clusters": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"can_upgrade": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If the cluster can be upgraded",
+							MarkdownDescription: "If the cluster can be upgraded",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Time of the cluster creation",
+							MarkdownDescription: "Time of the cluster creation",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "UUID of the cluster",
+							MarkdownDescription: "UUID of the cluster",
+						},
+						"kube_role_version": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Kube role version of the cluster, the target version while the cluster is being upgraded",
+							MarkdownDescription: "Kube role version of the cluster, the target version while the cluster is being upgraded",
+						},
+						"master_nodes_count": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Number of master nodes attached to the cluster. Null for the clusters of other tenants",
+							MarkdownDescription: "Number of master nodes attached to the cluster. Null for the clusters of other tenants",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Name of the cluster",
+							MarkdownDescription: "Name of the cluster",
+						},
+						"network_plugin": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Network plugin of the cluster",
+							MarkdownDescription: "Network plugin of the cluster",
+						},
+						"node_pool_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Name of the node pool of the cluster",
+							MarkdownDescription: "Name of the node pool of the cluster",
+						},
+						"node_pool_uuid": schema.StringAttribute{
+							Computed:            true,
+							Description:         "UUID of the node pool of the cluster",
+							MarkdownDescription: "UUID of the node pool of the cluster",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "UUID of the tenant the cluster belongs to",
+							MarkdownDescription: "UUID of the tenant the cluster belongs to",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Status of the cluster",
+							MarkdownDescription: "Status of the cluster",
+						},
+						"tags": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "Tags of the cluster",
+							MarkdownDescription: "Tags of the cluster",
+						},
+						"worker_nodes_count": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Number of worker nodes attached to the cluster. Null for the clusters of other tenants",
+							MarkdownDescription: "Number of worker nodes attached to the cluster. Null for the clusters of other tenants",
+						},
+					},
+					CustomType: ClustersType{
+						ObjectType: types.ObjectType{
+							AttrTypes: ClustersValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "Clusters matching the filters",
+				MarkdownDescription: "Clusters matching the filters",
+			},
 			"filters": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							Required:            true,
-							Description:         "Name of the attribute on which this filter should be applied. The tags attribute is a special case where the name has to be specified as 'tags:<tag_key>'",
-							MarkdownDescription: "Name of the attribute on which this filter should be applied. The tags attribute is a special case where the name has to be specified as 'tags:<tag_key>'",
+							Description:         "Name of the attribute on which this filter should be applied: name, tenant, tenant_id, status, kube_role_version, network_plugin, can_upgrade, node_pool_name or node_pool_uuid. The tags attribute is a special case where the name has to be specified as 'tags:<tag_key>'",
+							MarkdownDescription: "Name of the attribute on which this filter should be applied: name, tenant, tenant_id, status, kube_role_version, network_plugin, can_upgrade, node_pool_name or node_pool_uuid. The tags attribute is a special case where the name has to be specified as 'tags:<tag_key>'",
+						},
+						"operator": schema.StringAttribute{
+							Optional:            true,
+							Description:         "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							MarkdownDescription: "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							Validators: []validator.String{
+								stringvalidator.OneOf("exact", "prefix", "regex", "not"),
+							},
 						},
 						"regexes": schema.SetAttribute{
 							ElementType:         types.StringType,
@@ -52,22 +142,1027 @@ func ClustersDataSourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 				Optional:            true,
-				Description:         "List of filters.",
-				MarkdownDescription: "List of filters.",
+				Description:         "List of filters. An item is selected if it matches all the filters.",
+				MarkdownDescription: "List of filters. An item is selected if it matches all the filters.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Placeholder for an ID",
+				MarkdownDescription: "Placeholder for an ID",
+			},
+		},
+	}
+}
+
+type ClustersModel struct {
+	ClusterIds types.List   `tfsdk:"cluster_ids"`
+	Clusters   types.List   `tfsdk:"clusters"`
+	Filters    types.List   `tfsdk:"filters"`
+	Id         types.String `tfsdk:"id"`
+}
+
+var _ basetypes.ObjectTypable = ClustersType{}
+
+type ClustersType struct {
+	basetypes.ObjectType
+}
+
+func (t ClustersType) Equal(o attr.Type) bool {
+	other, ok := o.(ClustersType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ClustersType) String() string {
+	return "ClustersType"
+}
+
+func (t ClustersType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	canUpgradeAttribute, ok := attributes["can_upgrade"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`can_upgrade is missing from object`)
+
+		return nil, diags
+	}
+
+	canUpgradeVal, ok := canUpgradeAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`can_upgrade expected to be basetypes.BoolValue, was: %T`, canUpgradeAttribute))
+	}
+
+	createdAtAttribute, ok := attributes["created_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`created_at is missing from object`)
+
+		return nil, diags
+	}
+
+	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	kubeRoleVersionAttribute, ok := attributes["kube_role_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`kube_role_version is missing from object`)
+
+		return nil, diags
+	}
+
+	kubeRoleVersionVal, ok := kubeRoleVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`kube_role_version expected to be basetypes.StringValue, was: %T`, kubeRoleVersionAttribute))
+	}
+
+	masterNodesCountAttribute, ok := attributes["master_nodes_count"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`master_nodes_count is missing from object`)
+
+		return nil, diags
+	}
+
+	masterNodesCountVal, ok := masterNodesCountAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`master_nodes_count expected to be basetypes.Int64Value, was: %T`, masterNodesCountAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	networkPluginAttribute, ok := attributes["network_plugin"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`network_plugin is missing from object`)
+
+		return nil, diags
+	}
+
+	networkPluginVal, ok := networkPluginAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`network_plugin expected to be basetypes.StringValue, was: %T`, networkPluginAttribute))
+	}
+
+	nodePoolNameAttribute, ok := attributes["node_pool_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`node_pool_name is missing from object`)
+
+		return nil, diags
+	}
+
+	nodePoolNameVal, ok := nodePoolNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`node_pool_name expected to be basetypes.StringValue, was: %T`, nodePoolNameAttribute))
+	}
+
+	nodePoolUuidAttribute, ok := attributes["node_pool_uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`node_pool_uuid is missing from object`)
+
+		return nil, diags
+	}
+
+	nodePoolUuidVal, ok := nodePoolUuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`node_pool_uuid expected to be basetypes.StringValue, was: %T`, nodePoolUuidAttribute))
+	}
+
+	projectIdAttribute, ok := attributes["project_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`project_id is missing from object`)
+
+		return nil, diags
+	}
+
+	projectIdVal, ok := projectIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`project_id expected to be basetypes.StringValue, was: %T`, projectIdAttribute))
+	}
+
+	statusAttribute, ok := attributes["status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`status is missing from object`)
+
+		return nil, diags
+	}
+
+	statusVal, ok := statusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
+	}
+
+	tagsAttribute, ok := attributes["tags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`tags is missing from object`)
+
+		return nil, diags
+	}
+
+	tagsVal, ok := tagsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`tags expected to be basetypes.MapValue, was: %T`, tagsAttribute))
+	}
+
+	workerNodesCountAttribute, ok := attributes["worker_nodes_count"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`worker_nodes_count is missing from object`)
+
+		return nil, diags
+	}
+
+	workerNodesCountVal, ok := workerNodesCountAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`worker_nodes_count expected to be basetypes.Int64Value, was: %T`, workerNodesCountAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ClustersValue{
+		CanUpgrade:       canUpgradeVal,
+		CreatedAt:        createdAtVal,
+		Id:               idVal,
+		KubeRoleVersion:  kubeRoleVersionVal,
+		MasterNodesCount: masterNodesCountVal,
+		Name:             nameVal,
+		NetworkPlugin:    networkPluginVal,
+		NodePoolName:     nodePoolNameVal,
+		NodePoolUuid:     nodePoolUuidVal,
+		ProjectId:        projectIdVal,
+		Status:           statusVal,
+		Tags:             tagsVal,
+		WorkerNodesCount: workerNodesCountVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewClustersValueNull() ClustersValue {
+	return ClustersValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewClustersValueUnknown() ClustersValue {
+	return ClustersValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewClustersValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ClustersValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ClustersValue Attribute Value",
+				"While creating a ClustersValue value, a missing attribute value was detected. "+
+					"A ClustersValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ClustersValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ClustersValue Attribute Type",
+				"While creating a ClustersValue value, an invalid attribute value was detected. "+
+					"A ClustersValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ClustersValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ClustersValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ClustersValue Attribute Value",
+				"While creating a ClustersValue value, an extra attribute value was detected. "+
+					"A ClustersValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ClustersValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewClustersValueUnknown(), diags
+	}
+
+	canUpgradeAttribute, ok := attributes["can_upgrade"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`can_upgrade is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	canUpgradeVal, ok := canUpgradeAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`can_upgrade expected to be basetypes.BoolValue, was: %T`, canUpgradeAttribute))
+	}
+
+	createdAtAttribute, ok := attributes["created_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`created_at is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	kubeRoleVersionAttribute, ok := attributes["kube_role_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`kube_role_version is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	kubeRoleVersionVal, ok := kubeRoleVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`kube_role_version expected to be basetypes.StringValue, was: %T`, kubeRoleVersionAttribute))
+	}
+
+	masterNodesCountAttribute, ok := attributes["master_nodes_count"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`master_nodes_count is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	masterNodesCountVal, ok := masterNodesCountAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`master_nodes_count expected to be basetypes.Int64Value, was: %T`, masterNodesCountAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	networkPluginAttribute, ok := attributes["network_plugin"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`network_plugin is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	networkPluginVal, ok := networkPluginAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`network_plugin expected to be basetypes.StringValue, was: %T`, networkPluginAttribute))
+	}
+
+	nodePoolNameAttribute, ok := attributes["node_pool_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`node_pool_name is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	nodePoolNameVal, ok := nodePoolNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`node_pool_name expected to be basetypes.StringValue, was: %T`, nodePoolNameAttribute))
+	}
+
+	nodePoolUuidAttribute, ok := attributes["node_pool_uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`node_pool_uuid is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	nodePoolUuidVal, ok := nodePoolUuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`node_pool_uuid expected to be basetypes.StringValue, was: %T`, nodePoolUuidAttribute))
+	}
+
+	projectIdAttribute, ok := attributes["project_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`project_id is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	projectIdVal, ok := projectIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`project_id expected to be basetypes.StringValue, was: %T`, projectIdAttribute))
+	}
+
+	statusAttribute, ok := attributes["status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`status is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	statusVal, ok := statusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
+	}
+
+	tagsAttribute, ok := attributes["tags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`tags is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	tagsVal, ok := tagsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`tags expected to be basetypes.MapValue, was: %T`, tagsAttribute))
+	}
+
+	workerNodesCountAttribute, ok := attributes["worker_nodes_count"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`worker_nodes_count is missing from object`)
+
+		return NewClustersValueUnknown(), diags
+	}
+
+	workerNodesCountVal, ok := workerNodesCountAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`worker_nodes_count expected to be basetypes.Int64Value, was: %T`, workerNodesCountAttribute))
+	}
+
+	if diags.HasError() {
+		return NewClustersValueUnknown(), diags
+	}
+
+	return ClustersValue{
+		CanUpgrade:       canUpgradeVal,
+		CreatedAt:        createdAtVal,
+		Id:               idVal,
+		KubeRoleVersion:  kubeRoleVersionVal,
+		MasterNodesCount: masterNodesCountVal,
+		Name:             nameVal,
+		NetworkPlugin:    networkPluginVal,
+		NodePoolName:     nodePoolNameVal,
+		NodePoolUuid:     nodePoolUuidVal,
+		ProjectId:        projectIdVal,
+		Status:           statusVal,
+		Tags:             tagsVal,
+		WorkerNodesCount: workerNodesCountVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewClustersValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ClustersValue {
+	object, diags := NewClustersValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewClustersValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ClustersType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewClustersValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewClustersValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewClustersValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewClustersValueMust(ClustersValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ClustersType) ValueType(ctx context.Context) attr.Value {
+	return ClustersValue{}
+}
+
+var _ basetypes.ObjectValuable = ClustersValue{}
+
+type ClustersValue struct {
+	CanUpgrade       basetypes.BoolValue   `tfsdk:"can_upgrade"`
+	CreatedAt        basetypes.StringValue `tfsdk:"created_at"`
+	Id               basetypes.StringValue `tfsdk:"id"`
+	KubeRoleVersion  basetypes.StringValue `tfsdk:"kube_role_version"`
+	MasterNodesCount basetypes.Int64Value  `tfsdk:"master_nodes_count"`
+	Name             basetypes.StringValue `tfsdk:"name"`
+	NetworkPlugin    basetypes.StringValue `tfsdk:"network_plugin"`
+	NodePoolName     basetypes.StringValue `tfsdk:"node_pool_name"`
+	NodePoolUuid     basetypes.StringValue `tfsdk:"node_pool_uuid"`
+	ProjectId        basetypes.StringValue `tfsdk:"project_id"`
+	Status           basetypes.StringValue `tfsdk:"status"`
+	Tags             basetypes.MapValue    `tfsdk:"tags"`
+	WorkerNodesCount basetypes.Int64Value  `tfsdk:"worker_nodes_count"`
+	state            attr.ValueState
+}
+
+func (v ClustersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 13)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["can_upgrade"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["created_at"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["kube_role_version"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["master_nodes_count"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["network_plugin"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["node_pool_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["node_pool_uuid"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["project_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["tags"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["worker_nodes_count"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 13)
+
+		val, err = v.CanUpgrade.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["can_upgrade"] = val
+
+		val, err = v.CreatedAt.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["created_at"] = val
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.KubeRoleVersion.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["kube_role_version"] = val
+
+		val, err = v.MasterNodesCount.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["master_nodes_count"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.NetworkPlugin.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["network_plugin"] = val
+
+		val, err = v.NodePoolName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["node_pool_name"] = val
+
+		val, err = v.NodePoolUuid.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["node_pool_uuid"] = val
+
+		val, err = v.ProjectId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["project_id"] = val
+
+		val, err = v.Status.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["status"] = val
+
+		val, err = v.Tags.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["tags"] = val
+
+		val, err = v.WorkerNodesCount.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["worker_nodes_count"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ClustersValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ClustersValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ClustersValue) String() string {
+	return "ClustersValue"
+}
+
+func (v ClustersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tagsVal, d := types.MapValue(types.StringType, v.Tags.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"can_upgrade":        basetypes.BoolType{},
+			"created_at":         basetypes.StringType{},
+			"id":                 basetypes.StringType{},
+			"kube_role_version":  basetypes.StringType{},
+			"master_nodes_count": basetypes.Int64Type{},
+			"name":               basetypes.StringType{},
+			"network_plugin":     basetypes.StringType{},
+			"node_pool_name":     basetypes.StringType{},
+			"node_pool_uuid":     basetypes.StringType{},
+			"project_id":         basetypes.StringType{},
+			"status":             basetypes.StringType{},
+			"tags": basetypes.MapType{
+				ElemType: types.StringType,
 			},
-			"id": schema.StringAttribute{
-				Computed:            true,
-				Description:         "Placeholder for an ID",
-				MarkdownDescription: "Placeholder for an ID",
+			"worker_nodes_count": basetypes.Int64Type{},
+		}), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"can_upgrade":        basetypes.BoolType{},
+			"created_at":         basetypes.StringType{},
+			"id":                 basetypes.StringType{},
+			"kube_role_version":  basetypes.StringType{},
+			"master_nodes_count": basetypes.Int64Type{},
+			"name":               basetypes.StringType{},
+			"network_plugin":     basetypes.StringType{},
+			"node_pool_name":     basetypes.StringType{},
+			"node_pool_uuid":     basetypes.StringType{},
+			"project_id":         basetypes.StringType{},
+			"status":             basetypes.StringType{},
+			"tags": basetypes.MapType{
+				ElemType: types.StringType,
 			},
+			"worker_nodes_count": basetypes.Int64Type{},
+		},
+		map[string]attr.Value{
+			"can_upgrade":        v.CanUpgrade,
+			"created_at":         v.CreatedAt,
+			"id":                 v.Id,
+			"kube_role_version":  v.KubeRoleVersion,
+			"master_nodes_count": v.MasterNodesCount,
+			"name":               v.Name,
+			"network_plugin":     v.NetworkPlugin,
+			"node_pool_name":     v.NodePoolName,
+			"node_pool_uuid":     v.NodePoolUuid,
+			"project_id":         v.ProjectId,
+			"status":             v.Status,
+			"tags":               tagsVal,
+			"worker_nodes_count": v.WorkerNodesCount,
+		})
+
+	return objVal, diags
+}
+
+func (v ClustersValue) Equal(o attr.Value) bool {
+	other, ok := o.(ClustersValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.CanUpgrade.Equal(other.CanUpgrade) {
+		return false
+	}
+
+	if !v.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.KubeRoleVersion.Equal(other.KubeRoleVersion) {
+		return false
+	}
+
+	if !v.MasterNodesCount.Equal(other.MasterNodesCount) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.NetworkPlugin.Equal(other.NetworkPlugin) {
+		return false
+	}
+
+	if !v.NodePoolName.Equal(other.NodePoolName) {
+		return false
+	}
+
+	if !v.NodePoolUuid.Equal(other.NodePoolUuid) {
+		return false
+	}
+
+	if !v.ProjectId.Equal(other.ProjectId) {
+		return false
+	}
+
+	if !v.Status.Equal(other.Status) {
+		return false
+	}
+
+	if !v.Tags.Equal(other.Tags) {
+		return false
+	}
+
+	if !v.WorkerNodesCount.Equal(other.WorkerNodesCount) {
+		return false
+	}
+
+	return true
+}
+
+func (v ClustersValue) Type(ctx context.Context) attr.Type {
+	return ClustersType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-type ClustersModel struct {
-	ClusterIds types.List   `tfsdk:"cluster_ids"`
-	Filters    types.List   `tfsdk:"filters"`
-	Id         types.String `tfsdk:"id"`
+func (v ClustersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"can_upgrade":        basetypes.BoolType{},
+		"created_at":         basetypes.StringType{},
+		"id":                 basetypes.StringType{},
+		"kube_role_version":  basetypes.StringType{},
+		"master_nodes_count": basetypes.Int64Type{},
+		"name":               basetypes.StringType{},
+		"network_plugin":     basetypes.StringType{},
+		"node_pool_name":     basetypes.StringType{},
+		"node_pool_uuid":     basetypes.StringType{},
+		"project_id":         basetypes.StringType{},
+		"status":             basetypes.StringType{},
+		"tags": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"worker_nodes_count": basetypes.Int64Type{},
+	}
 }
 
 var _ basetypes.ObjectTypable = FiltersType{}
@@ -113,6 +1208,24 @@ func (t FiltersType) ValueFromObject(ctx context.Context, in basetypes.ObjectVal
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return nil, diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
 	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
@@ -154,10 +1267,11 @@ func (t FiltersType) ValueFromObject(ctx context.Context, in basetypes.ObjectVal
 	}
 
 	return FiltersValue{
-		Name:    nameVal,
-		Regexes: regexesVal,
-		Values:  valuesVal,
-		state:   attr.ValueStateKnown,
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
@@ -242,6 +1356,24 @@ func NewFiltersValue(attributeTypes map[string]attr.Type, attributes map[string]
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return NewFiltersValueUnknown(), diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
 	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
@@ -283,10 +1415,11 @@ func NewFiltersValue(attributeTypes map[string]attr.Type, attributes map[string]
 	}
 
 	return FiltersValue{
-		Name:    nameVal,
-		Regexes: regexesVal,
-		Values:  valuesVal,
-		state:   attr.ValueStateKnown,
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
@@ -358,19 +1491,21 @@ func (t FiltersType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = FiltersValue{}
 
 type FiltersValue struct {
-	Name    basetypes.StringValue `tfsdk:"name"`
-	Regexes basetypes.SetValue    `tfsdk:"regexes"`
-	Values  basetypes.SetValue    `tfsdk:"values"`
-	state   attr.ValueState
+	Name     basetypes.StringValue `tfsdk:"name"`
+	Operator basetypes.StringValue `tfsdk:"operator"`
+	Regexes  basetypes.SetValue    `tfsdk:"regexes"`
+	Values   basetypes.SetValue    `tfsdk:"values"`
+	state    attr.ValueState
 }
 
 func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
+	attrTypes := make(map[string]tftypes.Type, 4)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["operator"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["regexes"] = basetypes.SetType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
@@ -382,7 +1517,7 @@ func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
+		vals := make(map[string]tftypes.Value, 4)
 
 		val, err = v.Name.ToTerraformValue(ctx)
 
@@ -392,6 +1527,14 @@ func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 
 		vals["name"] = val
 
+		val, err = v.Operator.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["operator"] = val
+
 		val, err = v.Regexes.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -443,7 +1586,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -459,7 +1603,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -471,7 +1616,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -480,9 +1626,10 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 			},
 		},
 		map[string]attr.Value{
-			"name":    v.Name,
-			"regexes": regexesVal,
-			"values":  valuesVal,
+			"name":     v.Name,
+			"operator": v.Operator,
+			"regexes":  regexesVal,
+			"values":   valuesVal,
 		})
 
 	return objVal, diags
@@ -507,6 +1654,10 @@ func (v FiltersValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.Operator.Equal(other.Operator) {
+		return false
+	}
+
 	if !v.Regexes.Equal(other.Regexes) {
 		return false
 	}
@@ -528,7 +1679,8 @@ func (v FiltersValue) Type(ctx context.Context) attr.Type {
 
 func (v FiltersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"name": basetypes.StringType{},
+		"name":     basetypes.StringType{},
+		"operator": basetypes.StringType{},
 		"regexes": basetypes.SetType{
 			ElemType: types.StringType,
 		},
@@ -5,8 +5,10 @@ package datasource_hosts
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -26,6 +28,14 @@ func HostsDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "Name of the attribute on which this filter should be applied.",
 							MarkdownDescription: "Name of the attribute on which this filter should be applied.",
 						},
+						"operator": schema.StringAttribute{
+							Optional:            true,
+							Description:         "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							MarkdownDescription: "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							Validators: []validator.String{
+								stringvalidator.OneOf("exact", "prefix", "regex", "not"),
+							},
+						},
 						"regexes": schema.SetAttribute{
 							ElementType:         types.StringType,
 							Optional:            true,
@@ -46,8 +56,8 @@ func HostsDataSourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 				Optional:            true,
-				Description:         "List of filters.",
-				MarkdownDescription: "List of filters.",
+				Description:         "List of filters. An item is selected if it matches all the filters.",
+				MarkdownDescription: "List of filters. An item is selected if it matches all the filters.",
 			},
 			"host_ids": schema.ListAttribute{
 				ElementType:         types.StringType,
@@ -55,6 +65,257 @@ func HostsDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "A list of IDs of resources that match all the specified filters.",
 				MarkdownDescription: "A list of IDs of resources that match all the specified filters.",
 			},
+			"hosts": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"arch": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Architecture of the host",
+							MarkdownDescription: "Architecture of the host",
+						},
+						"block_devices": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:            true,
+										Description:         "Name of the block device, e.g. sda",
+										MarkdownDescription: "Name of the block device, e.g. sda",
+									},
+									"size_bytes": schema.Int64Attribute{
+										Computed:            true,
+										Description:         "Size of the block device in bytes",
+										MarkdownDescription: "Size of the block device in bytes",
+									},
+									"type": schema.StringAttribute{
+										Computed:            true,
+										Description:         "Type of the block device, e.g. disk or part",
+										MarkdownDescription: "Type of the block device, e.g. disk or part",
+									},
+								},
+								CustomType: BlockDevicesType{
+									ObjectType: types.ObjectType{
+										AttrTypes: BlockDevicesValue{}.AttributeTypes(ctx),
+									},
+								},
+							},
+							Computed:            true,
+							Description:         "Block devices of the host, such as disks and their partitions, sorted by name",
+							MarkdownDescription: "Block devices of the host, such as disks and their partitions, sorted by name",
+						},
+						"cpu": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"cores": schema.Int64Attribute{
+									Computed:            true,
+									Description:         "Number of CPU cores",
+									MarkdownDescription: "Number of CPU cores",
+								},
+								"model": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Model name of the CPU",
+									MarkdownDescription: "Model name of the CPU",
+								},
+								"sockets": schema.Int64Attribute{
+									Computed:            true,
+									Description:         "Number of CPU sockets",
+									MarkdownDescription: "Number of CPU sockets",
+								},
+								"threads": schema.Int64Attribute{
+									Computed:            true,
+									Description:         "Number of CPU threads",
+									MarkdownDescription: "Number of CPU threads",
+								},
+								"vendor": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Vendor of the CPU",
+									MarkdownDescription: "Vendor of the CPU",
+								},
+							},
+							CustomType: CpuType{
+								ObjectType: types.ObjectType{
+									AttrTypes: CpuValue{}.AttributeTypes(ctx),
+								},
+							},
+							Computed:            true,
+							Description:         "CPU of the host",
+							MarkdownDescription: "CPU of the host",
+						},
+						"gpu_present": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If the host has a GPU, null if not reported",
+							MarkdownDescription: "If the host has a GPU, null if not reported",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Name of the host",
+							MarkdownDescription: "Name of the host",
+						},
+						"hypervisor": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Type of the hypervisor, null on bare metal",
+									MarkdownDescription: "Type of the hypervisor, null on bare metal",
+								},
+								"virtual": schema.BoolAttribute{
+									Computed:            true,
+									Description:         "If the host is a virtual machine",
+									MarkdownDescription: "If the host is a virtual machine",
+								},
+							},
+							CustomType: HypervisorType{
+								ObjectType: types.ObjectType{
+									AttrTypes: HypervisorValue{}.AttributeTypes(ctx),
+								},
+							},
+							Computed:            true,
+							Description:         "Hypervisor the host runs on",
+							MarkdownDescription: "Hypervisor the host runs on",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "UUID of the host",
+							MarkdownDescription: "UUID of the host",
+						},
+						"interfaces": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"ip": schema.StringAttribute{
+										Computed:            true,
+										Description:         "IP address of the interface",
+										MarkdownDescription: "IP address of the interface",
+									},
+									"mac": schema.StringAttribute{
+										Computed:            true,
+										Description:         "MAC address of the interface",
+										MarkdownDescription: "MAC address of the interface",
+									},
+									"mtu": schema.Int64Attribute{
+										Computed:            true,
+										Description:         "MTU of the interface",
+										MarkdownDescription: "MTU of the interface",
+									},
+									"name": schema.StringAttribute{
+										Computed:            true,
+										Description:         "Name of the interface",
+										MarkdownDescription: "Name of the interface",
+									},
+									"netmask": schema.StringAttribute{
+										Computed:            true,
+										Description:         "Netmask of the IP address of the interface",
+										MarkdownDescription: "Netmask of the IP address of the interface",
+									},
+								},
+								CustomType: InterfacesType{
+									ObjectType: types.ObjectType{
+										AttrTypes: InterfacesValue{}.AttributeTypes(ctx),
+									},
+								},
+							},
+							Computed:            true,
+							Description:         "Network interfaces of the host, sorted by name",
+							MarkdownDescription: "Network interfaces of the host, sorted by name",
+						},
+						"last_response_time": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Time of the last response from the host",
+							MarkdownDescription: "Time of the last response from the host",
+						},
+						"memory": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"available_bytes": schema.Int64Attribute{
+									Computed:            true,
+									Description:         "Available memory in bytes",
+									MarkdownDescription: "Available memory in bytes",
+								},
+								"total_bytes": schema.Int64Attribute{
+									Computed:            true,
+									Description:         "Total memory in bytes",
+									MarkdownDescription: "Total memory in bytes",
+								},
+							},
+							CustomType: MemoryType{
+								ObjectType: types.ObjectType{
+									AttrTypes: MemoryValue{}.AttributeTypes(ctx),
+								},
+							},
+							Computed:            true,
+							Description:         "Memory of the host",
+							MarkdownDescription: "Memory of the host",
+						},
+						"message": schema.StringAttribute{
+							Computed: true,
+						},
+						"os_family": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Operating system family of the host",
+							MarkdownDescription: "Operating system family of the host",
+						},
+						"os_info": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Operating system information",
+							MarkdownDescription: "Operating system information",
+						},
+						"responding": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Indicates if the host is responding",
+							MarkdownDescription: "Indicates if the host is responding",
+						},
+						"role_status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Status of the role",
+							MarkdownDescription: "Status of the role",
+						},
+						"roles": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "Roles of the host",
+							MarkdownDescription: "Roles of the host",
+						},
+						"time_skew_seconds": schema.Float64Attribute{
+							Computed:            true,
+							Description:         "Offset of the host clock from its NTP servers in seconds, converted from the milliseconds reported by the hostagent. Null if not reported",
+							MarkdownDescription: "Offset of the host clock from its NTP servers in seconds, converted from the milliseconds reported by the hostagent. Null if not reported",
+						},
+						"volume_groups": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"free_bytes": schema.Int64Attribute{
+										Computed:            true,
+										Description:         "Free space of the volume group in bytes",
+										MarkdownDescription: "Free space of the volume group in bytes",
+									},
+									"name": schema.StringAttribute{
+										Computed:            true,
+										Description:         "Name of the volume group",
+										MarkdownDescription: "Name of the volume group",
+									},
+									"size_bytes": schema.Int64Attribute{
+										Computed:            true,
+										Description:         "Size of the volume group in bytes",
+										MarkdownDescription: "Size of the volume group in bytes",
+									},
+								},
+								CustomType: VolumeGroupsType{
+									ObjectType: types.ObjectType{
+										AttrTypes: VolumeGroupsValue{}.AttributeTypes(ctx),
+									},
+								},
+							},
+							Computed:            true,
+							Description:         "LVM volume groups of the host, sorted by name",
+							MarkdownDescription: "LVM volume groups of the host, sorted by name",
+						},
+					},
+					CustomType: HostsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: HostsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "Hosts matching the filters, with the same attributes as the pf9_host data source",
+				MarkdownDescription: "Hosts matching the filters, with the same attributes as the pf9_host data source",
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				Description:         "Placeholder for ID",
@@ -67,6 +328,7 @@ func HostsDataSourceSchema(ctx context.Context) schema.Schema {
 type HostsModel struct {
 	Filters types.List   `tfsdk:"filters"`
 	HostIds types.List   `tfsdk:"host_ids"`
+	Hosts   types.List   `tfsdk:"hosts"`
 	Id      types.String `tfsdk:"id"`
 }
 
@@ -113,6 +375,24 @@ func (t FiltersType) ValueFromObject(ctx context.Context, in basetypes.ObjectVal
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return nil, diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
 	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
@@ -154,10 +434,11 @@ func (t FiltersType) ValueFromObject(ctx context.Context, in basetypes.ObjectVal
 	}
 
 	return FiltersValue{
-		Name:    nameVal,
-		Regexes: regexesVal,
-		Values:  valuesVal,
-		state:   attr.ValueStateKnown,
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
@@ -242,6 +523,24 @@ func NewFiltersValue(attributeTypes map[string]attr.Type, attributes map[string]
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return NewFiltersValueUnknown(), diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
 	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
@@ -283,10 +582,11 @@ func NewFiltersValue(attributeTypes map[string]attr.Type, attributes map[string]
 	}
 
 	return FiltersValue{
-		Name:    nameVal,
-		Regexes: regexesVal,
-		Values:  valuesVal,
-		state:   attr.ValueStateKnown,
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
@@ -358,19 +658,21 @@ func (t FiltersType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = FiltersValue{}
 
 type FiltersValue struct {
-	Name    basetypes.StringValue `tfsdk:"name"`
-	Regexes basetypes.SetValue    `tfsdk:"regexes"`
-	Values  basetypes.SetValue    `tfsdk:"values"`
-	state   attr.ValueState
+	Name     basetypes.StringValue `tfsdk:"name"`
+	Operator basetypes.StringValue `tfsdk:"operator"`
+	Regexes  basetypes.SetValue    `tfsdk:"regexes"`
+	Values   basetypes.SetValue    `tfsdk:"values"`
+	state    attr.ValueState
 }
 
 func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
+	attrTypes := make(map[string]tftypes.Type, 4)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["operator"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["regexes"] = basetypes.SetType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
@@ -382,7 +684,7 @@ func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
+		vals := make(map[string]tftypes.Value, 4)
 
 		val, err = v.Name.ToTerraformValue(ctx)
 
@@ -392,6 +694,14 @@ func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 
 		vals["name"] = val
 
+		val, err = v.Operator.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["operator"] = val
+
 		val, err = v.Regexes.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -443,7 +753,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -459,7 +770,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -471,7 +783,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -480,9 +793,10 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 			},
 		},
 		map[string]attr.Value{
-			"name":    v.Name,
-			"regexes": regexesVal,
-			"values":  valuesVal,
+			"name":     v.Name,
+			"operator": v.Operator,
+			"regexes":  regexesVal,
+			"values":   valuesVal,
 		})
 
 	return objVal, diags
@@ -507,6 +821,10 @@ func (v FiltersValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.Operator.Equal(other.Operator) {
+		return false
+	}
+
 	if !v.Regexes.Equal(other.Regexes) {
 		return false
 	}
@@ -528,7 +846,8 @@ func (v FiltersValue) Type(ctx context.Context) attr.Type {
 
 func (v FiltersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"name": basetypes.StringType{},
+		"name":     basetypes.StringType{},
+		"operator": basetypes.StringType{},
 		"regexes": basetypes.SetType{
 			ElemType: types.StringType,
 		},
@@ -537,3 +856,4139 @@ func (v FiltersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 		},
 	}
 }
+
+var _ basetypes.ObjectTypable = HostsType{}
+
+type HostsType struct {
+	basetypes.ObjectType
+}
+
+func (t HostsType) Equal(o attr.Type) bool {
+	other, ok := o.(HostsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t HostsType) String() string {
+	return "HostsType"
+}
+
+func (t HostsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	archAttribute, ok := attributes["arch"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`arch is missing from object`)
+
+		return nil, diags
+	}
+
+	archVal, ok := archAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`arch expected to be basetypes.StringValue, was: %T`, archAttribute))
+	}
+
+	blockDevicesAttribute, ok := attributes["block_devices"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`block_devices is missing from object`)
+
+		return nil, diags
+	}
+
+	blockDevicesVal, ok := blockDevicesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`block_devices expected to be basetypes.ListValue, was: %T`, blockDevicesAttribute))
+	}
+
+	cpuAttribute, ok := attributes["cpu"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cpu is missing from object`)
+
+		return nil, diags
+	}
+
+	cpuVal, ok := cpuAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cpu expected to be basetypes.ObjectValue, was: %T`, cpuAttribute))
+	}
+
+	gpuPresentAttribute, ok := attributes["gpu_present"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`gpu_present is missing from object`)
+
+		return nil, diags
+	}
+
+	gpuPresentVal, ok := gpuPresentAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`gpu_present expected to be basetypes.BoolValue, was: %T`, gpuPresentAttribute))
+	}
+
+	hostnameAttribute, ok := attributes["hostname"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`hostname is missing from object`)
+
+		return nil, diags
+	}
+
+	hostnameVal, ok := hostnameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
+	}
+
+	hypervisorAttribute, ok := attributes["hypervisor"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`hypervisor is missing from object`)
+
+		return nil, diags
+	}
+
+	hypervisorVal, ok := hypervisorAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`hypervisor expected to be basetypes.ObjectValue, was: %T`, hypervisorAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	interfacesAttribute, ok := attributes["interfaces"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`interfaces is missing from object`)
+
+		return nil, diags
+	}
+
+	interfacesVal, ok := interfacesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`interfaces expected to be basetypes.ListValue, was: %T`, interfacesAttribute))
+	}
+
+	lastResponseTimeAttribute, ok := attributes["last_response_time"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_response_time is missing from object`)
+
+		return nil, diags
+	}
+
+	lastResponseTimeVal, ok := lastResponseTimeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_response_time expected to be basetypes.StringValue, was: %T`, lastResponseTimeAttribute))
+	}
+
+	memoryAttribute, ok := attributes["memory"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`memory is missing from object`)
+
+		return nil, diags
+	}
+
+	memoryVal, ok := memoryAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`memory expected to be basetypes.ObjectValue, was: %T`, memoryAttribute))
+	}
+
+	messageAttribute, ok := attributes["message"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`message is missing from object`)
+
+		return nil, diags
+	}
+
+	messageVal, ok := messageAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`message expected to be basetypes.StringValue, was: %T`, messageAttribute))
+	}
+
+	osFamilyAttribute, ok := attributes["os_family"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`os_family is missing from object`)
+
+		return nil, diags
+	}
+
+	osFamilyVal, ok := osFamilyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`os_family expected to be basetypes.StringValue, was: %T`, osFamilyAttribute))
+	}
+
+	osInfoAttribute, ok := attributes["os_info"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`os_info is missing from object`)
+
+		return nil, diags
+	}
+
+	osInfoVal, ok := osInfoAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`os_info expected to be basetypes.StringValue, was: %T`, osInfoAttribute))
+	}
+
+	respondingAttribute, ok := attributes["responding"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`responding is missing from object`)
+
+		return nil, diags
+	}
+
+	respondingVal, ok := respondingAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`responding expected to be basetypes.BoolValue, was: %T`, respondingAttribute))
+	}
+
+	roleStatusAttribute, ok := attributes["role_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_status is missing from object`)
+
+		return nil, diags
+	}
+
+	roleStatusVal, ok := roleStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_status expected to be basetypes.StringValue, was: %T`, roleStatusAttribute))
+	}
+
+	rolesAttribute, ok := attributes["roles"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`roles is missing from object`)
+
+		return nil, diags
+	}
+
+	rolesVal, ok := rolesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`roles expected to be basetypes.ListValue, was: %T`, rolesAttribute))
+	}
+
+	timeSkewSecondsAttribute, ok := attributes["time_skew_seconds"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`time_skew_seconds is missing from object`)
+
+		return nil, diags
+	}
+
+	timeSkewSecondsVal, ok := timeSkewSecondsAttribute.(basetypes.Float64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`time_skew_seconds expected to be basetypes.Float64Value, was: %T`, timeSkewSecondsAttribute))
+	}
+
+	volumeGroupsAttribute, ok := attributes["volume_groups"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`volume_groups is missing from object`)
+
+		return nil, diags
+	}
+
+	volumeGroupsVal, ok := volumeGroupsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`volume_groups expected to be basetypes.ListValue, was: %T`, volumeGroupsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return HostsValue{
+		Arch:             archVal,
+		BlockDevices:     blockDevicesVal,
+		Cpu:              cpuVal,
+		GpuPresent:       gpuPresentVal,
+		Hostname:         hostnameVal,
+		Hypervisor:       hypervisorVal,
+		Id:               idVal,
+		Interfaces:       interfacesVal,
+		LastResponseTime: lastResponseTimeVal,
+		Memory:           memoryVal,
+		Message:          messageVal,
+		OsFamily:         osFamilyVal,
+		OsInfo:           osInfoVal,
+		Responding:       respondingVal,
+		RoleStatus:       roleStatusVal,
+		Roles:            rolesVal,
+		TimeSkewSeconds:  timeSkewSecondsVal,
+		VolumeGroups:     volumeGroupsVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewHostsValueNull() HostsValue {
+	return HostsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewHostsValueUnknown() HostsValue {
+	return HostsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewHostsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (HostsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing HostsValue Attribute Value",
+				"While creating a HostsValue value, a missing attribute value was detected. "+
+					"A HostsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("HostsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid HostsValue Attribute Type",
+				"While creating a HostsValue value, an invalid attribute value was detected. "+
+					"A HostsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("HostsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("HostsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra HostsValue Attribute Value",
+				"While creating a HostsValue value, an extra attribute value was detected. "+
+					"A HostsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra HostsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewHostsValueUnknown(), diags
+	}
+
+	archAttribute, ok := attributes["arch"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`arch is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	archVal, ok := archAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`arch expected to be basetypes.StringValue, was: %T`, archAttribute))
+	}
+
+	blockDevicesAttribute, ok := attributes["block_devices"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`block_devices is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	blockDevicesVal, ok := blockDevicesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`block_devices expected to be basetypes.ListValue, was: %T`, blockDevicesAttribute))
+	}
+
+	cpuAttribute, ok := attributes["cpu"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cpu is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	cpuVal, ok := cpuAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cpu expected to be basetypes.ObjectValue, was: %T`, cpuAttribute))
+	}
+
+	gpuPresentAttribute, ok := attributes["gpu_present"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`gpu_present is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	gpuPresentVal, ok := gpuPresentAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`gpu_present expected to be basetypes.BoolValue, was: %T`, gpuPresentAttribute))
+	}
+
+	hostnameAttribute, ok := attributes["hostname"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`hostname is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	hostnameVal, ok := hostnameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
+	}
+
+	hypervisorAttribute, ok := attributes["hypervisor"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`hypervisor is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	hypervisorVal, ok := hypervisorAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`hypervisor expected to be basetypes.ObjectValue, was: %T`, hypervisorAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	interfacesAttribute, ok := attributes["interfaces"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`interfaces is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	interfacesVal, ok := interfacesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`interfaces expected to be basetypes.ListValue, was: %T`, interfacesAttribute))
+	}
+
+	lastResponseTimeAttribute, ok := attributes["last_response_time"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_response_time is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	lastResponseTimeVal, ok := lastResponseTimeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_response_time expected to be basetypes.StringValue, was: %T`, lastResponseTimeAttribute))
+	}
+
+	memoryAttribute, ok := attributes["memory"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`memory is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	memoryVal, ok := memoryAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`memory expected to be basetypes.ObjectValue, was: %T`, memoryAttribute))
+	}
+
+	messageAttribute, ok := attributes["message"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`message is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	messageVal, ok := messageAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`message expected to be basetypes.StringValue, was: %T`, messageAttribute))
+	}
+
+	osFamilyAttribute, ok := attributes["os_family"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`os_family is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	osFamilyVal, ok := osFamilyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`os_family expected to be basetypes.StringValue, was: %T`, osFamilyAttribute))
+	}
+
+	osInfoAttribute, ok := attributes["os_info"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`os_info is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	osInfoVal, ok := osInfoAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`os_info expected to be basetypes.StringValue, was: %T`, osInfoAttribute))
+	}
+
+	respondingAttribute, ok := attributes["responding"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`responding is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	respondingVal, ok := respondingAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`responding expected to be basetypes.BoolValue, was: %T`, respondingAttribute))
+	}
+
+	roleStatusAttribute, ok := attributes["role_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_status is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	roleStatusVal, ok := roleStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_status expected to be basetypes.StringValue, was: %T`, roleStatusAttribute))
+	}
+
+	rolesAttribute, ok := attributes["roles"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`roles is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	rolesVal, ok := rolesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`roles expected to be basetypes.ListValue, was: %T`, rolesAttribute))
+	}
+
+	timeSkewSecondsAttribute, ok := attributes["time_skew_seconds"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`time_skew_seconds is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	timeSkewSecondsVal, ok := timeSkewSecondsAttribute.(basetypes.Float64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`time_skew_seconds expected to be basetypes.Float64Value, was: %T`, timeSkewSecondsAttribute))
+	}
+
+	volumeGroupsAttribute, ok := attributes["volume_groups"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`volume_groups is missing from object`)
+
+		return NewHostsValueUnknown(), diags
+	}
+
+	volumeGroupsVal, ok := volumeGroupsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`volume_groups expected to be basetypes.ListValue, was: %T`, volumeGroupsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewHostsValueUnknown(), diags
+	}
+
+	return HostsValue{
+		Arch:             archVal,
+		BlockDevices:     blockDevicesVal,
+		Cpu:              cpuVal,
+		GpuPresent:       gpuPresentVal,
+		Hostname:         hostnameVal,
+		Hypervisor:       hypervisorVal,
+		Id:               idVal,
+		Interfaces:       interfacesVal,
+		LastResponseTime: lastResponseTimeVal,
+		Memory:           memoryVal,
+		Message:          messageVal,
+		OsFamily:         osFamilyVal,
+		OsInfo:           osInfoVal,
+		Responding:       respondingVal,
+		RoleStatus:       roleStatusVal,
+		Roles:            rolesVal,
+		TimeSkewSeconds:  timeSkewSecondsVal,
+		VolumeGroups:     volumeGroupsVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewHostsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) HostsValue {
+	object, diags := NewHostsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewHostsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t HostsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewHostsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewHostsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewHostsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewHostsValueMust(HostsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t HostsType) ValueType(ctx context.Context) attr.Value {
+	return HostsValue{}
+}
+
+var _ basetypes.ObjectValuable = HostsValue{}
+
+type HostsValue struct {
+	Arch             basetypes.StringValue  `tfsdk:"arch"`
+	BlockDevices     basetypes.ListValue    `tfsdk:"block_devices"`
+	Cpu              basetypes.ObjectValue  `tfsdk:"cpu"`
+	GpuPresent       basetypes.BoolValue    `tfsdk:"gpu_present"`
+	Hostname         basetypes.StringValue  `tfsdk:"hostname"`
+	Hypervisor       basetypes.ObjectValue  `tfsdk:"hypervisor"`
+	Id               basetypes.StringValue  `tfsdk:"id"`
+	Interfaces       basetypes.ListValue    `tfsdk:"interfaces"`
+	LastResponseTime basetypes.StringValue  `tfsdk:"last_response_time"`
+	Memory           basetypes.ObjectValue  `tfsdk:"memory"`
+	Message          basetypes.StringValue  `tfsdk:"message"`
+	OsFamily         basetypes.StringValue  `tfsdk:"os_family"`
+	OsInfo           basetypes.StringValue  `tfsdk:"os_info"`
+	Responding       basetypes.BoolValue    `tfsdk:"responding"`
+	RoleStatus       basetypes.StringValue  `tfsdk:"role_status"`
+	Roles            basetypes.ListValue    `tfsdk:"roles"`
+	TimeSkewSeconds  basetypes.Float64Value `tfsdk:"time_skew_seconds"`
+	VolumeGroups     basetypes.ListValue    `tfsdk:"volume_groups"`
+	state            attr.ValueState
+}
+
+func (v HostsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 18)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["arch"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["block_devices"] = basetypes.ListType{
+		ElemType: BlockDevicesValue{}.Type(ctx),
+	}.TerraformType(ctx)
+	attrTypes["cpu"] = basetypes.ObjectType{
+		AttrTypes: CpuValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["gpu_present"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["hostname"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["hypervisor"] = basetypes.ObjectType{
+		AttrTypes: HypervisorValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["interfaces"] = basetypes.ListType{
+		ElemType: InterfacesValue{}.Type(ctx),
+	}.TerraformType(ctx)
+	attrTypes["last_response_time"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["memory"] = basetypes.ObjectType{
+		AttrTypes: MemoryValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["message"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["os_family"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["os_info"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["responding"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["role_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["roles"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["time_skew_seconds"] = basetypes.Float64Type{}.TerraformType(ctx)
+	attrTypes["volume_groups"] = basetypes.ListType{
+		ElemType: VolumeGroupsValue{}.Type(ctx),
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 18)
+
+		val, err = v.Arch.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["arch"] = val
+
+		val, err = v.BlockDevices.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["block_devices"] = val
+
+		val, err = v.Cpu.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cpu"] = val
+
+		val, err = v.GpuPresent.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["gpu_present"] = val
+
+		val, err = v.Hostname.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["hostname"] = val
+
+		val, err = v.Hypervisor.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["hypervisor"] = val
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.Interfaces.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["interfaces"] = val
+
+		val, err = v.LastResponseTime.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["last_response_time"] = val
+
+		val, err = v.Memory.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["memory"] = val
+
+		val, err = v.Message.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["message"] = val
+
+		val, err = v.OsFamily.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["os_family"] = val
+
+		val, err = v.OsInfo.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["os_info"] = val
+
+		val, err = v.Responding.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["responding"] = val
+
+		val, err = v.RoleStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["role_status"] = val
+
+		val, err = v.Roles.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["roles"] = val
+
+		val, err = v.TimeSkewSeconds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["time_skew_seconds"] = val
+
+		val, err = v.VolumeGroups.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["volume_groups"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v HostsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v HostsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v HostsValue) String() string {
+	return "HostsValue"
+}
+
+func (v HostsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	blockDevices := types.ListValueMust(
+		BlockDevicesType{
+			basetypes.ObjectType{
+				AttrTypes: BlockDevicesValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.BlockDevices.Elements(),
+	)
+
+	if v.BlockDevices.IsNull() {
+		blockDevices = types.ListNull(
+			BlockDevicesType{
+				basetypes.ObjectType{
+					AttrTypes: BlockDevicesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.BlockDevices.IsUnknown() {
+		blockDevices = types.ListUnknown(
+			BlockDevicesType{
+				basetypes.ObjectType{
+					AttrTypes: BlockDevicesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	var cpu basetypes.ObjectValue
+
+	if v.Cpu.IsNull() {
+		cpu = types.ObjectNull(
+			CpuValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Cpu.IsUnknown() {
+		cpu = types.ObjectUnknown(
+			CpuValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Cpu.IsNull() && !v.Cpu.IsUnknown() {
+		cpu = types.ObjectValueMust(
+			CpuValue{}.AttributeTypes(ctx),
+			v.Cpu.Attributes(),
+		)
+	}
+
+	var hypervisor basetypes.ObjectValue
+
+	if v.Hypervisor.IsNull() {
+		hypervisor = types.ObjectNull(
+			HypervisorValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Hypervisor.IsUnknown() {
+		hypervisor = types.ObjectUnknown(
+			HypervisorValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Hypervisor.IsNull() && !v.Hypervisor.IsUnknown() {
+		hypervisor = types.ObjectValueMust(
+			HypervisorValue{}.AttributeTypes(ctx),
+			v.Hypervisor.Attributes(),
+		)
+	}
+
+	interfaces := types.ListValueMust(
+		InterfacesType{
+			basetypes.ObjectType{
+				AttrTypes: InterfacesValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.Interfaces.Elements(),
+	)
+
+	if v.Interfaces.IsNull() {
+		interfaces = types.ListNull(
+			InterfacesType{
+				basetypes.ObjectType{
+					AttrTypes: InterfacesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.Interfaces.IsUnknown() {
+		interfaces = types.ListUnknown(
+			InterfacesType{
+				basetypes.ObjectType{
+					AttrTypes: InterfacesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	var memory basetypes.ObjectValue
+
+	if v.Memory.IsNull() {
+		memory = types.ObjectNull(
+			MemoryValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Memory.IsUnknown() {
+		memory = types.ObjectUnknown(
+			MemoryValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Memory.IsNull() && !v.Memory.IsUnknown() {
+		memory = types.ObjectValueMust(
+			MemoryValue{}.AttributeTypes(ctx),
+			v.Memory.Attributes(),
+		)
+	}
+
+	rolesVal, d := types.ListValue(types.StringType, v.Roles.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"arch": basetypes.StringType{},
+			"block_devices": basetypes.ListType{
+				ElemType: BlockDevicesValue{}.Type(ctx),
+			},
+			"cpu": basetypes.ObjectType{
+				AttrTypes: CpuValue{}.AttributeTypes(ctx),
+			},
+			"gpu_present": basetypes.BoolType{},
+			"hostname":    basetypes.StringType{},
+			"hypervisor": basetypes.ObjectType{
+				AttrTypes: HypervisorValue{}.AttributeTypes(ctx),
+			},
+			"id": basetypes.StringType{},
+			"interfaces": basetypes.ListType{
+				ElemType: InterfacesValue{}.Type(ctx),
+			},
+			"last_response_time": basetypes.StringType{},
+			"memory": basetypes.ObjectType{
+				AttrTypes: MemoryValue{}.AttributeTypes(ctx),
+			},
+			"message":     basetypes.StringType{},
+			"os_family":   basetypes.StringType{},
+			"os_info":     basetypes.StringType{},
+			"responding":  basetypes.BoolType{},
+			"role_status": basetypes.StringType{},
+			"roles": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"time_skew_seconds": basetypes.Float64Type{},
+			"volume_groups": basetypes.ListType{
+				ElemType: VolumeGroupsValue{}.Type(ctx),
+			},
+		}), diags
+	}
+
+	volumeGroups := types.ListValueMust(
+		VolumeGroupsType{
+			basetypes.ObjectType{
+				AttrTypes: VolumeGroupsValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.VolumeGroups.Elements(),
+	)
+
+	if v.VolumeGroups.IsNull() {
+		volumeGroups = types.ListNull(
+			VolumeGroupsType{
+				basetypes.ObjectType{
+					AttrTypes: VolumeGroupsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.VolumeGroups.IsUnknown() {
+		volumeGroups = types.ListUnknown(
+			VolumeGroupsType{
+				basetypes.ObjectType{
+					AttrTypes: VolumeGroupsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"arch": basetypes.StringType{},
+			"block_devices": basetypes.ListType{
+				ElemType: BlockDevicesValue{}.Type(ctx),
+			},
+			"cpu": basetypes.ObjectType{
+				AttrTypes: CpuValue{}.AttributeTypes(ctx),
+			},
+			"gpu_present": basetypes.BoolType{},
+			"hostname":    basetypes.StringType{},
+			"hypervisor": basetypes.ObjectType{
+				AttrTypes: HypervisorValue{}.AttributeTypes(ctx),
+			},
+			"id": basetypes.StringType{},
+			"interfaces": basetypes.ListType{
+				ElemType: InterfacesValue{}.Type(ctx),
+			},
+			"last_response_time": basetypes.StringType{},
+			"memory": basetypes.ObjectType{
+				AttrTypes: MemoryValue{}.AttributeTypes(ctx),
+			},
+			"message":     basetypes.StringType{},
+			"os_family":   basetypes.StringType{},
+			"os_info":     basetypes.StringType{},
+			"responding":  basetypes.BoolType{},
+			"role_status": basetypes.StringType{},
+			"roles": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"time_skew_seconds": basetypes.Float64Type{},
+			"volume_groups": basetypes.ListType{
+				ElemType: VolumeGroupsValue{}.Type(ctx),
+			},
+		},
+		map[string]attr.Value{
+			"arch":               v.Arch,
+			"block_devices":      blockDevices,
+			"cpu":                cpu,
+			"gpu_present":        v.GpuPresent,
+			"hostname":           v.Hostname,
+			"hypervisor":         hypervisor,
+			"id":                 v.Id,
+			"interfaces":         interfaces,
+			"last_response_time": v.LastResponseTime,
+			"memory":             memory,
+			"message":            v.Message,
+			"os_family":          v.OsFamily,
+			"os_info":            v.OsInfo,
+			"responding":         v.Responding,
+			"role_status":        v.RoleStatus,
+			"roles":              rolesVal,
+			"time_skew_seconds":  v.TimeSkewSeconds,
+			"volume_groups":      volumeGroups,
+		})
+
+	return objVal, diags
+}
+
+func (v HostsValue) Equal(o attr.Value) bool {
+	other, ok := o.(HostsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Arch.Equal(other.Arch) {
+		return false
+	}
+
+	if !v.BlockDevices.Equal(other.BlockDevices) {
+		return false
+	}
+
+	if !v.Cpu.Equal(other.Cpu) {
+		return false
+	}
+
+	if !v.GpuPresent.Equal(other.GpuPresent) {
+		return false
+	}
+
+	if !v.Hostname.Equal(other.Hostname) {
+		return false
+	}
+
+	if !v.Hypervisor.Equal(other.Hypervisor) {
+		return false
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.Interfaces.Equal(other.Interfaces) {
+		return false
+	}
+
+	if !v.LastResponseTime.Equal(other.LastResponseTime) {
+		return false
+	}
+
+	if !v.Memory.Equal(other.Memory) {
+		return false
+	}
+
+	if !v.Message.Equal(other.Message) {
+		return false
+	}
+
+	if !v.OsFamily.Equal(other.OsFamily) {
+		return false
+	}
+
+	if !v.OsInfo.Equal(other.OsInfo) {
+		return false
+	}
+
+	if !v.Responding.Equal(other.Responding) {
+		return false
+	}
+
+	if !v.RoleStatus.Equal(other.RoleStatus) {
+		return false
+	}
+
+	if !v.Roles.Equal(other.Roles) {
+		return false
+	}
+
+	if !v.TimeSkewSeconds.Equal(other.TimeSkewSeconds) {
+		return false
+	}
+
+	if !v.VolumeGroups.Equal(other.VolumeGroups) {
+		return false
+	}
+
+	return true
+}
+
+func (v HostsValue) Type(ctx context.Context) attr.Type {
+	return HostsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v HostsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"arch": basetypes.StringType{},
+		"block_devices": basetypes.ListType{
+			ElemType: BlockDevicesValue{}.Type(ctx),
+		},
+		"cpu": basetypes.ObjectType{
+			AttrTypes: CpuValue{}.AttributeTypes(ctx),
+		},
+		"gpu_present": basetypes.BoolType{},
+		"hostname":    basetypes.StringType{},
+		"hypervisor": basetypes.ObjectType{
+			AttrTypes: HypervisorValue{}.AttributeTypes(ctx),
+		},
+		"id": basetypes.StringType{},
+		"interfaces": basetypes.ListType{
+			ElemType: InterfacesValue{}.Type(ctx),
+		},
+		"last_response_time": basetypes.StringType{},
+		"memory": basetypes.ObjectType{
+			AttrTypes: MemoryValue{}.AttributeTypes(ctx),
+		},
+		"message":     basetypes.StringType{},
+		"os_family":   basetypes.StringType{},
+		"os_info":     basetypes.StringType{},
+		"responding":  basetypes.BoolType{},
+		"role_status": basetypes.StringType{},
+		"roles": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"time_skew_seconds": basetypes.Float64Type{},
+		"volume_groups": basetypes.ListType{
+			ElemType: VolumeGroupsValue{}.Type(ctx),
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = BlockDevicesType{}
+
+type BlockDevicesType struct {
+	basetypes.ObjectType
+}
+
+func (t BlockDevicesType) Equal(o attr.Type) bool {
+	other, ok := o.(BlockDevicesType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t BlockDevicesType) String() string {
+	return "BlockDevicesType"
+}
+
+func (t BlockDevicesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	sizeBytesAttribute, ok := attributes["size_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size_bytes is missing from object`)
+
+		return nil, diags
+	}
+
+	sizeBytesVal, ok := sizeBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size_bytes expected to be basetypes.Int64Value, was: %T`, sizeBytesAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return nil, diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return BlockDevicesValue{
+		Name:             nameVal,
+		SizeBytes:        sizeBytesVal,
+		BlockDevicesType: typeVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewBlockDevicesValueNull() BlockDevicesValue {
+	return BlockDevicesValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewBlockDevicesValueUnknown() BlockDevicesValue {
+	return BlockDevicesValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewBlockDevicesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (BlockDevicesValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing BlockDevicesValue Attribute Value",
+				"While creating a BlockDevicesValue value, a missing attribute value was detected. "+
+					"A BlockDevicesValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("BlockDevicesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid BlockDevicesValue Attribute Type",
+				"While creating a BlockDevicesValue value, an invalid attribute value was detected. "+
+					"A BlockDevicesValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("BlockDevicesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("BlockDevicesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra BlockDevicesValue Attribute Value",
+				"While creating a BlockDevicesValue value, an extra attribute value was detected. "+
+					"A BlockDevicesValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra BlockDevicesValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewBlockDevicesValueUnknown(), diags
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewBlockDevicesValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	sizeBytesAttribute, ok := attributes["size_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size_bytes is missing from object`)
+
+		return NewBlockDevicesValueUnknown(), diags
+	}
+
+	sizeBytesVal, ok := sizeBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size_bytes expected to be basetypes.Int64Value, was: %T`, sizeBytesAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return NewBlockDevicesValueUnknown(), diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewBlockDevicesValueUnknown(), diags
+	}
+
+	return BlockDevicesValue{
+		Name:             nameVal,
+		SizeBytes:        sizeBytesVal,
+		BlockDevicesType: typeVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewBlockDevicesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) BlockDevicesValue {
+	object, diags := NewBlockDevicesValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewBlockDevicesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t BlockDevicesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewBlockDevicesValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewBlockDevicesValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewBlockDevicesValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewBlockDevicesValueMust(BlockDevicesValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t BlockDevicesType) ValueType(ctx context.Context) attr.Value {
+	return BlockDevicesValue{}
+}
+
+var _ basetypes.ObjectValuable = BlockDevicesValue{}
+
+type BlockDevicesValue struct {
+	Name             basetypes.StringValue `tfsdk:"name"`
+	SizeBytes        basetypes.Int64Value  `tfsdk:"size_bytes"`
+	BlockDevicesType basetypes.StringValue `tfsdk:"type"`
+	state            attr.ValueState
+}
+
+func (v BlockDevicesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["size_bytes"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 3)
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.SizeBytes.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["size_bytes"] = val
+
+		val, err = v.BlockDevicesType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["type"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v BlockDevicesValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v BlockDevicesValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v BlockDevicesValue) String() string {
+	return "BlockDevicesValue"
+}
+
+func (v BlockDevicesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"name":       basetypes.StringType{},
+			"size_bytes": basetypes.Int64Type{},
+			"type":       basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"name":       v.Name,
+			"size_bytes": v.SizeBytes,
+			"type":       v.BlockDevicesType,
+		})
+
+	return objVal, diags
+}
+
+func (v BlockDevicesValue) Equal(o attr.Value) bool {
+	other, ok := o.(BlockDevicesValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.SizeBytes.Equal(other.SizeBytes) {
+		return false
+	}
+
+	if !v.BlockDevicesType.Equal(other.BlockDevicesType) {
+		return false
+	}
+
+	return true
+}
+
+func (v BlockDevicesValue) Type(ctx context.Context) attr.Type {
+	return BlockDevicesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v BlockDevicesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":       basetypes.StringType{},
+		"size_bytes": basetypes.Int64Type{},
+		"type":       basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = CpuType{}
+
+type CpuType struct {
+	basetypes.ObjectType
+}
+
+func (t CpuType) Equal(o attr.Type) bool {
+	other, ok := o.(CpuType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t CpuType) String() string {
+	return "CpuType"
+}
+
+func (t CpuType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	coresAttribute, ok := attributes["cores"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cores is missing from object`)
+
+		return nil, diags
+	}
+
+	coresVal, ok := coresAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cores expected to be basetypes.Int64Value, was: %T`, coresAttribute))
+	}
+
+	modelAttribute, ok := attributes["model"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`model is missing from object`)
+
+		return nil, diags
+	}
+
+	modelVal, ok := modelAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`model expected to be basetypes.StringValue, was: %T`, modelAttribute))
+	}
+
+	socketsAttribute, ok := attributes["sockets"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sockets is missing from object`)
+
+		return nil, diags
+	}
+
+	socketsVal, ok := socketsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sockets expected to be basetypes.Int64Value, was: %T`, socketsAttribute))
+	}
+
+	threadsAttribute, ok := attributes["threads"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`threads is missing from object`)
+
+		return nil, diags
+	}
+
+	threadsVal, ok := threadsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`threads expected to be basetypes.Int64Value, was: %T`, threadsAttribute))
+	}
+
+	vendorAttribute, ok := attributes["vendor"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`vendor is missing from object`)
+
+		return nil, diags
+	}
+
+	vendorVal, ok := vendorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`vendor expected to be basetypes.StringValue, was: %T`, vendorAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return CpuValue{
+		Cores:   coresVal,
+		Model:   modelVal,
+		Sockets: socketsVal,
+		Threads: threadsVal,
+		Vendor:  vendorVal,
+		state:   attr.ValueStateKnown,
+	}, diags
+}
+
+func NewCpuValueNull() CpuValue {
+	return CpuValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewCpuValueUnknown() CpuValue {
+	return CpuValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewCpuValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CpuValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing CpuValue Attribute Value",
+				"While creating a CpuValue value, a missing attribute value was detected. "+
+					"A CpuValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("CpuValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid CpuValue Attribute Type",
+				"While creating a CpuValue value, an invalid attribute value was detected. "+
+					"A CpuValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("CpuValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("CpuValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra CpuValue Attribute Value",
+				"While creating a CpuValue value, an extra attribute value was detected. "+
+					"A CpuValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra CpuValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewCpuValueUnknown(), diags
+	}
+
+	coresAttribute, ok := attributes["cores"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cores is missing from object`)
+
+		return NewCpuValueUnknown(), diags
+	}
+
+	coresVal, ok := coresAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cores expected to be basetypes.Int64Value, was: %T`, coresAttribute))
+	}
+
+	modelAttribute, ok := attributes["model"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`model is missing from object`)
+
+		return NewCpuValueUnknown(), diags
+	}
+
+	modelVal, ok := modelAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`model expected to be basetypes.StringValue, was: %T`, modelAttribute))
+	}
+
+	socketsAttribute, ok := attributes["sockets"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sockets is missing from object`)
+
+		return NewCpuValueUnknown(), diags
+	}
+
+	socketsVal, ok := socketsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sockets expected to be basetypes.Int64Value, was: %T`, socketsAttribute))
+	}
+
+	threadsAttribute, ok := attributes["threads"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`threads is missing from object`)
+
+		return NewCpuValueUnknown(), diags
+	}
+
+	threadsVal, ok := threadsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`threads expected to be basetypes.Int64Value, was: %T`, threadsAttribute))
+	}
+
+	vendorAttribute, ok := attributes["vendor"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`vendor is missing from object`)
+
+		return NewCpuValueUnknown(), diags
+	}
+
+	vendorVal, ok := vendorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`vendor expected to be basetypes.StringValue, was: %T`, vendorAttribute))
+	}
+
+	if diags.HasError() {
+		return NewCpuValueUnknown(), diags
+	}
+
+	return CpuValue{
+		Cores:   coresVal,
+		Model:   modelVal,
+		Sockets: socketsVal,
+		Threads: threadsVal,
+		Vendor:  vendorVal,
+		state:   attr.ValueStateKnown,
+	}, diags
+}
+
+func NewCpuValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CpuValue {
+	object, diags := NewCpuValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewCpuValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t CpuType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewCpuValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewCpuValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewCpuValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewCpuValueMust(CpuValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t CpuType) ValueType(ctx context.Context) attr.Value {
+	return CpuValue{}
+}
+
+var _ basetypes.ObjectValuable = CpuValue{}
+
+type CpuValue struct {
+	Cores   basetypes.Int64Value  `tfsdk:"cores"`
+	Model   basetypes.StringValue `tfsdk:"model"`
+	Sockets basetypes.Int64Value  `tfsdk:"sockets"`
+	Threads basetypes.Int64Value  `tfsdk:"threads"`
+	Vendor  basetypes.StringValue `tfsdk:"vendor"`
+	state   attr.ValueState
+}
+
+func (v CpuValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 5)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["cores"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["model"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["sockets"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["threads"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["vendor"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 5)
+
+		val, err = v.Cores.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cores"] = val
+
+		val, err = v.Model.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["model"] = val
+
+		val, err = v.Sockets.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["sockets"] = val
+
+		val, err = v.Threads.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["threads"] = val
+
+		val, err = v.Vendor.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["vendor"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v CpuValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v CpuValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v CpuValue) String() string {
+	return "CpuValue"
+}
+
+func (v CpuValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"cores":   basetypes.Int64Type{},
+			"model":   basetypes.StringType{},
+			"sockets": basetypes.Int64Type{},
+			"threads": basetypes.Int64Type{},
+			"vendor":  basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"cores":   v.Cores,
+			"model":   v.Model,
+			"sockets": v.Sockets,
+			"threads": v.Threads,
+			"vendor":  v.Vendor,
+		})
+
+	return objVal, diags
+}
+
+func (v CpuValue) Equal(o attr.Value) bool {
+	other, ok := o.(CpuValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Cores.Equal(other.Cores) {
+		return false
+	}
+
+	if !v.Model.Equal(other.Model) {
+		return false
+	}
+
+	if !v.Sockets.Equal(other.Sockets) {
+		return false
+	}
+
+	if !v.Threads.Equal(other.Threads) {
+		return false
+	}
+
+	if !v.Vendor.Equal(other.Vendor) {
+		return false
+	}
+
+	return true
+}
+
+func (v CpuValue) Type(ctx context.Context) attr.Type {
+	return CpuType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v CpuValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"cores":   basetypes.Int64Type{},
+		"model":   basetypes.StringType{},
+		"sockets": basetypes.Int64Type{},
+		"threads": basetypes.Int64Type{},
+		"vendor":  basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = HypervisorType{}
+
+type HypervisorType struct {
+	basetypes.ObjectType
+}
+
+func (t HypervisorType) Equal(o attr.Type) bool {
+	other, ok := o.(HypervisorType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t HypervisorType) String() string {
+	return "HypervisorType"
+}
+
+func (t HypervisorType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return nil, diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	virtualAttribute, ok := attributes["virtual"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`virtual is missing from object`)
+
+		return nil, diags
+	}
+
+	virtualVal, ok := virtualAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`virtual expected to be basetypes.BoolValue, was: %T`, virtualAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return HypervisorValue{
+		HypervisorType: typeVal,
+		Virtual:        virtualVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewHypervisorValueNull() HypervisorValue {
+	return HypervisorValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewHypervisorValueUnknown() HypervisorValue {
+	return HypervisorValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewHypervisorValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (HypervisorValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing HypervisorValue Attribute Value",
+				"While creating a HypervisorValue value, a missing attribute value was detected. "+
+					"A HypervisorValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("HypervisorValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid HypervisorValue Attribute Type",
+				"While creating a HypervisorValue value, an invalid attribute value was detected. "+
+					"A HypervisorValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("HypervisorValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("HypervisorValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra HypervisorValue Attribute Value",
+				"While creating a HypervisorValue value, an extra attribute value was detected. "+
+					"A HypervisorValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra HypervisorValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewHypervisorValueUnknown(), diags
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return NewHypervisorValueUnknown(), diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	virtualAttribute, ok := attributes["virtual"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`virtual is missing from object`)
+
+		return NewHypervisorValueUnknown(), diags
+	}
+
+	virtualVal, ok := virtualAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`virtual expected to be basetypes.BoolValue, was: %T`, virtualAttribute))
+	}
+
+	if diags.HasError() {
+		return NewHypervisorValueUnknown(), diags
+	}
+
+	return HypervisorValue{
+		HypervisorType: typeVal,
+		Virtual:        virtualVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewHypervisorValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) HypervisorValue {
+	object, diags := NewHypervisorValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewHypervisorValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t HypervisorType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewHypervisorValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewHypervisorValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewHypervisorValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewHypervisorValueMust(HypervisorValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t HypervisorType) ValueType(ctx context.Context) attr.Value {
+	return HypervisorValue{}
+}
+
+var _ basetypes.ObjectValuable = HypervisorValue{}
+
+type HypervisorValue struct {
+	HypervisorType basetypes.StringValue `tfsdk:"type"`
+	Virtual        basetypes.BoolValue   `tfsdk:"virtual"`
+	state          attr.ValueState
+}
+
+func (v HypervisorValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["virtual"] = basetypes.BoolType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.HypervisorType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["type"] = val
+
+		val, err = v.Virtual.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["virtual"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v HypervisorValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v HypervisorValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v HypervisorValue) String() string {
+	return "HypervisorValue"
+}
+
+func (v HypervisorValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"type":    basetypes.StringType{},
+			"virtual": basetypes.BoolType{},
+		},
+		map[string]attr.Value{
+			"type":    v.HypervisorType,
+			"virtual": v.Virtual,
+		})
+
+	return objVal, diags
+}
+
+func (v HypervisorValue) Equal(o attr.Value) bool {
+	other, ok := o.(HypervisorValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.HypervisorType.Equal(other.HypervisorType) {
+		return false
+	}
+
+	if !v.Virtual.Equal(other.Virtual) {
+		return false
+	}
+
+	return true
+}
+
+func (v HypervisorValue) Type(ctx context.Context) attr.Type {
+	return HypervisorType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v HypervisorValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":    basetypes.StringType{},
+		"virtual": basetypes.BoolType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = InterfacesType{}
+
+type InterfacesType struct {
+	basetypes.ObjectType
+}
+
+func (t InterfacesType) Equal(o attr.Type) bool {
+	other, ok := o.(InterfacesType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t InterfacesType) String() string {
+	return "InterfacesType"
+}
+
+func (t InterfacesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	ipAttribute, ok := attributes["ip"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ip is missing from object`)
+
+		return nil, diags
+	}
+
+	ipVal, ok := ipAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ip expected to be basetypes.StringValue, was: %T`, ipAttribute))
+	}
+
+	macAttribute, ok := attributes["mac"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`mac is missing from object`)
+
+		return nil, diags
+	}
+
+	macVal, ok := macAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`mac expected to be basetypes.StringValue, was: %T`, macAttribute))
+	}
+
+	mtuAttribute, ok := attributes["mtu"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`mtu is missing from object`)
+
+		return nil, diags
+	}
+
+	mtuVal, ok := mtuAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`mtu expected to be basetypes.Int64Value, was: %T`, mtuAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	netmaskAttribute, ok := attributes["netmask"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`netmask is missing from object`)
+
+		return nil, diags
+	}
+
+	netmaskVal, ok := netmaskAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`netmask expected to be basetypes.StringValue, was: %T`, netmaskAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return InterfacesValue{
+		Ip:      ipVal,
+		Mac:     macVal,
+		Mtu:     mtuVal,
+		Name:    nameVal,
+		Netmask: netmaskVal,
+		state:   attr.ValueStateKnown,
+	}, diags
+}
+
+func NewInterfacesValueNull() InterfacesValue {
+	return InterfacesValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewInterfacesValueUnknown() InterfacesValue {
+	return InterfacesValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewInterfacesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (InterfacesValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing InterfacesValue Attribute Value",
+				"While creating a InterfacesValue value, a missing attribute value was detected. "+
+					"A InterfacesValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("InterfacesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid InterfacesValue Attribute Type",
+				"While creating a InterfacesValue value, an invalid attribute value was detected. "+
+					"A InterfacesValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("InterfacesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("InterfacesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra InterfacesValue Attribute Value",
+				"While creating a InterfacesValue value, an extra attribute value was detected. "+
+					"A InterfacesValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra InterfacesValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewInterfacesValueUnknown(), diags
+	}
+
+	ipAttribute, ok := attributes["ip"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ip is missing from object`)
+
+		return NewInterfacesValueUnknown(), diags
+	}
+
+	ipVal, ok := ipAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ip expected to be basetypes.StringValue, was: %T`, ipAttribute))
+	}
+
+	macAttribute, ok := attributes["mac"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`mac is missing from object`)
+
+		return NewInterfacesValueUnknown(), diags
+	}
+
+	macVal, ok := macAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`mac expected to be basetypes.StringValue, was: %T`, macAttribute))
+	}
+
+	mtuAttribute, ok := attributes["mtu"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`mtu is missing from object`)
+
+		return NewInterfacesValueUnknown(), diags
+	}
+
+	mtuVal, ok := mtuAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`mtu expected to be basetypes.Int64Value, was: %T`, mtuAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewInterfacesValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	netmaskAttribute, ok := attributes["netmask"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`netmask is missing from object`)
+
+		return NewInterfacesValueUnknown(), diags
+	}
+
+	netmaskVal, ok := netmaskAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`netmask expected to be basetypes.StringValue, was: %T`, netmaskAttribute))
+	}
+
+	if diags.HasError() {
+		return NewInterfacesValueUnknown(), diags
+	}
+
+	return InterfacesValue{
+		Ip:      ipVal,
+		Mac:     macVal,
+		Mtu:     mtuVal,
+		Name:    nameVal,
+		Netmask: netmaskVal,
+		state:   attr.ValueStateKnown,
+	}, diags
+}
+
+func NewInterfacesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) InterfacesValue {
+	object, diags := NewInterfacesValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewInterfacesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t InterfacesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewInterfacesValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewInterfacesValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewInterfacesValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewInterfacesValueMust(InterfacesValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t InterfacesType) ValueType(ctx context.Context) attr.Value {
+	return InterfacesValue{}
+}
+
+var _ basetypes.ObjectValuable = InterfacesValue{}
+
+type InterfacesValue struct {
+	Ip      basetypes.StringValue `tfsdk:"ip"`
+	Mac     basetypes.StringValue `tfsdk:"mac"`
+	Mtu     basetypes.Int64Value  `tfsdk:"mtu"`
+	Name    basetypes.StringValue `tfsdk:"name"`
+	Netmask basetypes.StringValue `tfsdk:"netmask"`
+	state   attr.ValueState
+}
+
+func (v InterfacesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 5)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["ip"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["mac"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["mtu"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["netmask"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 5)
+
+		val, err = v.Ip.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ip"] = val
+
+		val, err = v.Mac.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["mac"] = val
+
+		val, err = v.Mtu.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["mtu"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.Netmask.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["netmask"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v InterfacesValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v InterfacesValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v InterfacesValue) String() string {
+	return "InterfacesValue"
+}
+
+func (v InterfacesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"ip":      basetypes.StringType{},
+			"mac":     basetypes.StringType{},
+			"mtu":     basetypes.Int64Type{},
+			"name":    basetypes.StringType{},
+			"netmask": basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"ip":      v.Ip,
+			"mac":     v.Mac,
+			"mtu":     v.Mtu,
+			"name":    v.Name,
+			"netmask": v.Netmask,
+		})
+
+	return objVal, diags
+}
+
+func (v InterfacesValue) Equal(o attr.Value) bool {
+	other, ok := o.(InterfacesValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Ip.Equal(other.Ip) {
+		return false
+	}
+
+	if !v.Mac.Equal(other.Mac) {
+		return false
+	}
+
+	if !v.Mtu.Equal(other.Mtu) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.Netmask.Equal(other.Netmask) {
+		return false
+	}
+
+	return true
+}
+
+func (v InterfacesValue) Type(ctx context.Context) attr.Type {
+	return InterfacesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v InterfacesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"ip":      basetypes.StringType{},
+		"mac":     basetypes.StringType{},
+		"mtu":     basetypes.Int64Type{},
+		"name":    basetypes.StringType{},
+		"netmask": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = MemoryType{}
+
+type MemoryType struct {
+	basetypes.ObjectType
+}
+
+func (t MemoryType) Equal(o attr.Type) bool {
+	other, ok := o.(MemoryType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t MemoryType) String() string {
+	return "MemoryType"
+}
+
+func (t MemoryType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	availableBytesAttribute, ok := attributes["available_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`available_bytes is missing from object`)
+
+		return nil, diags
+	}
+
+	availableBytesVal, ok := availableBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`available_bytes expected to be basetypes.Int64Value, was: %T`, availableBytesAttribute))
+	}
+
+	totalBytesAttribute, ok := attributes["total_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`total_bytes is missing from object`)
+
+		return nil, diags
+	}
+
+	totalBytesVal, ok := totalBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`total_bytes expected to be basetypes.Int64Value, was: %T`, totalBytesAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return MemoryValue{
+		AvailableBytes: availableBytesVal,
+		TotalBytes:     totalBytesVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewMemoryValueNull() MemoryValue {
+	return MemoryValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewMemoryValueUnknown() MemoryValue {
+	return MemoryValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewMemoryValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (MemoryValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing MemoryValue Attribute Value",
+				"While creating a MemoryValue value, a missing attribute value was detected. "+
+					"A MemoryValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("MemoryValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid MemoryValue Attribute Type",
+				"While creating a MemoryValue value, an invalid attribute value was detected. "+
+					"A MemoryValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("MemoryValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("MemoryValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra MemoryValue Attribute Value",
+				"While creating a MemoryValue value, an extra attribute value was detected. "+
+					"A MemoryValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra MemoryValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewMemoryValueUnknown(), diags
+	}
+
+	availableBytesAttribute, ok := attributes["available_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`available_bytes is missing from object`)
+
+		return NewMemoryValueUnknown(), diags
+	}
+
+	availableBytesVal, ok := availableBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`available_bytes expected to be basetypes.Int64Value, was: %T`, availableBytesAttribute))
+	}
+
+	totalBytesAttribute, ok := attributes["total_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`total_bytes is missing from object`)
+
+		return NewMemoryValueUnknown(), diags
+	}
+
+	totalBytesVal, ok := totalBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`total_bytes expected to be basetypes.Int64Value, was: %T`, totalBytesAttribute))
+	}
+
+	if diags.HasError() {
+		return NewMemoryValueUnknown(), diags
+	}
+
+	return MemoryValue{
+		AvailableBytes: availableBytesVal,
+		TotalBytes:     totalBytesVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewMemoryValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) MemoryValue {
+	object, diags := NewMemoryValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewMemoryValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t MemoryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewMemoryValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewMemoryValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewMemoryValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewMemoryValueMust(MemoryValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t MemoryType) ValueType(ctx context.Context) attr.Value {
+	return MemoryValue{}
+}
+
+var _ basetypes.ObjectValuable = MemoryValue{}
+
+type MemoryValue struct {
+	AvailableBytes basetypes.Int64Value `tfsdk:"available_bytes"`
+	TotalBytes     basetypes.Int64Value `tfsdk:"total_bytes"`
+	state          attr.ValueState
+}
+
+func (v MemoryValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["available_bytes"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["total_bytes"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.AvailableBytes.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["available_bytes"] = val
+
+		val, err = v.TotalBytes.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["total_bytes"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v MemoryValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v MemoryValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v MemoryValue) String() string {
+	return "MemoryValue"
+}
+
+func (v MemoryValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"available_bytes": basetypes.Int64Type{},
+			"total_bytes":     basetypes.Int64Type{},
+		},
+		map[string]attr.Value{
+			"available_bytes": v.AvailableBytes,
+			"total_bytes":     v.TotalBytes,
+		})
+
+	return objVal, diags
+}
+
+func (v MemoryValue) Equal(o attr.Value) bool {
+	other, ok := o.(MemoryValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.AvailableBytes.Equal(other.AvailableBytes) {
+		return false
+	}
+
+	if !v.TotalBytes.Equal(other.TotalBytes) {
+		return false
+	}
+
+	return true
+}
+
+func (v MemoryValue) Type(ctx context.Context) attr.Type {
+	return MemoryType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v MemoryValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"available_bytes": basetypes.Int64Type{},
+		"total_bytes":     basetypes.Int64Type{},
+	}
+}
+
+var _ basetypes.ObjectTypable = VolumeGroupsType{}
+
+type VolumeGroupsType struct {
+	basetypes.ObjectType
+}
+
+func (t VolumeGroupsType) Equal(o attr.Type) bool {
+	other, ok := o.(VolumeGroupsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t VolumeGroupsType) String() string {
+	return "VolumeGroupsType"
+}
+
+func (t VolumeGroupsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	freeBytesAttribute, ok := attributes["free_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`free_bytes is missing from object`)
+
+		return nil, diags
+	}
+
+	freeBytesVal, ok := freeBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`free_bytes expected to be basetypes.Int64Value, was: %T`, freeBytesAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	sizeBytesAttribute, ok := attributes["size_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size_bytes is missing from object`)
+
+		return nil, diags
+	}
+
+	sizeBytesVal, ok := sizeBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size_bytes expected to be basetypes.Int64Value, was: %T`, sizeBytesAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return VolumeGroupsValue{
+		FreeBytes: freeBytesVal,
+		Name:      nameVal,
+		SizeBytes: sizeBytesVal,
+		state:     attr.ValueStateKnown,
+	}, diags
+}
+
+func NewVolumeGroupsValueNull() VolumeGroupsValue {
+	return VolumeGroupsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewVolumeGroupsValueUnknown() VolumeGroupsValue {
+	return VolumeGroupsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewVolumeGroupsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (VolumeGroupsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing VolumeGroupsValue Attribute Value",
+				"While creating a VolumeGroupsValue value, a missing attribute value was detected. "+
+					"A VolumeGroupsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("VolumeGroupsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid VolumeGroupsValue Attribute Type",
+				"While creating a VolumeGroupsValue value, an invalid attribute value was detected. "+
+					"A VolumeGroupsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("VolumeGroupsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("VolumeGroupsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra VolumeGroupsValue Attribute Value",
+				"While creating a VolumeGroupsValue value, an extra attribute value was detected. "+
+					"A VolumeGroupsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra VolumeGroupsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewVolumeGroupsValueUnknown(), diags
+	}
+
+	freeBytesAttribute, ok := attributes["free_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`free_bytes is missing from object`)
+
+		return NewVolumeGroupsValueUnknown(), diags
+	}
+
+	freeBytesVal, ok := freeBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`free_bytes expected to be basetypes.Int64Value, was: %T`, freeBytesAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewVolumeGroupsValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	sizeBytesAttribute, ok := attributes["size_bytes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size_bytes is missing from object`)
+
+		return NewVolumeGroupsValueUnknown(), diags
+	}
+
+	sizeBytesVal, ok := sizeBytesAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size_bytes expected to be basetypes.Int64Value, was: %T`, sizeBytesAttribute))
+	}
+
+	if diags.HasError() {
+		return NewVolumeGroupsValueUnknown(), diags
+	}
+
+	return VolumeGroupsValue{
+		FreeBytes: freeBytesVal,
+		Name:      nameVal,
+		SizeBytes: sizeBytesVal,
+		state:     attr.ValueStateKnown,
+	}, diags
+}
+
+func NewVolumeGroupsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) VolumeGroupsValue {
+	object, diags := NewVolumeGroupsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewVolumeGroupsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t VolumeGroupsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewVolumeGroupsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewVolumeGroupsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewVolumeGroupsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewVolumeGroupsValueMust(VolumeGroupsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t VolumeGroupsType) ValueType(ctx context.Context) attr.Value {
+	return VolumeGroupsValue{}
+}
+
+var _ basetypes.ObjectValuable = VolumeGroupsValue{}
+
+type VolumeGroupsValue struct {
+	FreeBytes basetypes.Int64Value  `tfsdk:"free_bytes"`
+	Name      basetypes.StringValue `tfsdk:"name"`
+	SizeBytes basetypes.Int64Value  `tfsdk:"size_bytes"`
+	state     attr.ValueState
+}
+
+func (v VolumeGroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["free_bytes"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["size_bytes"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 3)
+
+		val, err = v.FreeBytes.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["free_bytes"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.SizeBytes.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["size_bytes"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v VolumeGroupsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v VolumeGroupsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v VolumeGroupsValue) String() string {
+	return "VolumeGroupsValue"
+}
+
+func (v VolumeGroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"free_bytes": basetypes.Int64Type{},
+			"name":       basetypes.StringType{},
+			"size_bytes": basetypes.Int64Type{},
+		},
+		map[string]attr.Value{
+			"free_bytes": v.FreeBytes,
+			"name":       v.Name,
+			"size_bytes": v.SizeBytes,
+		})
+
+	return objVal, diags
+}
+
+func (v VolumeGroupsValue) Equal(o attr.Value) bool {
+	other, ok := o.(VolumeGroupsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.FreeBytes.Equal(other.FreeBytes) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.SizeBytes.Equal(other.SizeBytes) {
+		return false
+	}
+
+	return true
+}
+
+func (v VolumeGroupsValue) Type(ctx context.Context) attr.Type {
+	return VolumeGroupsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v VolumeGroupsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"free_bytes": basetypes.Int64Type{},
+		"name":       basetypes.StringType{},
+		"size_bytes": basetypes.Int64Type{},
+	}
+}
@@ -28,6 +28,11 @@ func KubeconfigDataSourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.OneOf("token", "password", "certificate"),
 				},
 			},
+			"exclude_secrets": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If true, raw and the token and client_key of the kubeconfigs are null so the credentials are not stored in the Terraform state. The other attributes, such as host and cluster_ca_certificate, are still set. Defaults to false",
+				MarkdownDescription: "If true, raw and the token and client_key of the kubeconfigs are null so the credentials are not stored in the Terraform state. The other attributes, such as host and cluster_ca_certificate, are still set. Defaults to false",
+			},
 			"id": schema.StringAttribute{
 				Required:            true,
 				Description:         "UUID of the cluster",
@@ -102,6 +107,7 @@ func KubeconfigDataSourceSchema(ctx context.Context) schema.Schema {
 
 type KubeconfigModel struct {
 	AuthenticationMethod types.String `tfsdk:"authentication_method"`
+	ExcludeSecrets       types.Bool   `tfsdk:"exclude_secrets"`
 	Id                   types.String `tfsdk:"id"`
 	Kubeconfigs          types.List   `tfsdk:"kubeconfigs"`
 	Raw                  types.String `tfsdk:"raw"`
@@ -4,6 +4,9 @@ package datasource_node
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,9 +34,13 @@ func NodeDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "UUID of the cluster the node belongs to",
 			},
 			"id": schema.StringAttribute{
-				Required:            true,
-				Description:         "UUID of the cluster",
-				MarkdownDescription: "UUID of the cluster",
+				Optional:            true,
+				Computed:            true,
+				Description:         "UUID of the node. Exactly one of id, name and primary_ip must be set",
+				MarkdownDescription: "UUID of the node. Exactly one of id, name and primary_ip must be set",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("primary_ip")),
+				},
 			},
 			"is_master": schema.BoolAttribute{
 				Computed:            true,
@@ -41,9 +48,10 @@ func NodeDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "true if this node is a master of a cluster.",
 			},
 			"name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "Host name of the node",
-				MarkdownDescription: "Host name of the node",
+				Description:         "Host name of the node. Looks up the node by name if set",
+				MarkdownDescription: "Host name of the node. Looks up the node by name if set",
 			},
 			"node_pool_name": schema.StringAttribute{
 				Computed:            true,
@@ -56,9 +64,10 @@ func NodeDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "UUID of the node pool, the node belongs to",
 			},
 			"primary_ip": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "IP address of the node",
-				MarkdownDescription: "IP address of the node",
+				Description:         "IP address of the node. Looks up the node by IP address if set",
+				MarkdownDescription: "IP address of the node. Looks up the node by IP address if set",
 			},
 			"project_id": schema.StringAttribute{
 				Computed: true,
@@ -23,16 +23,16 @@ func NodepoolsDataSourceSchema(ctx context.Context) schema.Schema {
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"name": schema.StringAttribute{
-						Required:            true,
+						Optional:            true,
 						Description:         "Name of the attribute on which this filter is applied",
 						MarkdownDescription: "Name of the attribute on which this filter is applied",
 						Validators: []validator.String{
-							stringvalidator.OneOf("name", "cloud_provider_name", "cloud_provider_uuid"),
+							stringvalidator.OneOf("id", "name", "cloud_provider_name", "cloud_provider_uuid", "node_ids", "cluster_ids"),
 						},
 					},
 					"values": schema.ListAttribute{
 						ElementType: types.StringType,
-						Required:    true,
+						Optional:    true,
 					},
 				},
 				CustomType: FilterType{
@@ -40,7 +40,49 @@ func NodepoolsDataSourceSchema(ctx context.Context) schema.Schema {
 						AttrTypes: FilterValue{}.AttributeTypes(ctx),
 					},
 				},
-				Required: true,
+				Optional:            true,
+				Description:         "Filter to apply to the list of nodepools",
+				MarkdownDescription: "Filter to apply to the list of nodepools",
+				DeprecationMessage:  "This field is deprecated and will be removed in a future release. Use the 'filters' field instead.",
+			},
+			"filters": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							Description:         "Name of the attribute on which this filter should be applied.",
+							MarkdownDescription: "Name of the attribute on which this filter should be applied.",
+						},
+						"operator": schema.StringAttribute{
+							Optional:            true,
+							Description:         "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							MarkdownDescription: "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							Validators: []validator.String{
+								stringvalidator.OneOf("exact", "prefix", "regex", "not"),
+							},
+						},
+						"regexes": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "Set of regexes to match to the attribute value, if any of the 'regex' matches then the filter is considered to be matched",
+							MarkdownDescription: "Set of regexes to match to the attribute value, if any of the 'regex' matches then the filter is considered to be matched",
+						},
+						"values": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "Set of values for the attribute, if any of the 'value' matches then the filter is considered to be matched",
+							MarkdownDescription: "Set of values for the attribute, if any of the 'value' matches then the filter is considered to be matched",
+						},
+					},
+					CustomType: FiltersType{
+						ObjectType: types.ObjectType{
+							AttrTypes: FiltersValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "List of filters. An item is selected if it matches all the filters.",
+				MarkdownDescription: "List of filters. An item is selected if it matches all the filters.",
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -60,6 +102,12 @@ func NodepoolsDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "UUID of the cloud provider",
 							MarkdownDescription: "UUID of the cloud provider",
 						},
+						"cluster_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "UUIDs of the clusters the nodes of the nodepool are attached to",
+							MarkdownDescription: "UUIDs of the clusters the nodes of the nodepool are attached to",
+						},
 						"id": schema.StringAttribute{
 							Computed:            true,
 							Description:         "UUID of the nodepool",
@@ -70,6 +118,12 @@ func NodepoolsDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "Name of the nodepool",
 							MarkdownDescription: "Name of the nodepool",
 						},
+						"node_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "UUIDs of the nodes in the nodepool",
+							MarkdownDescription: "UUIDs of the nodes in the nodepool",
+						},
 					},
 					CustomType: NodepoolsType{
 						ObjectType: types.ObjectType{
@@ -78,8 +132,8 @@ func NodepoolsDataSourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 				Computed:            true,
-				Description:         "List of nodepools matched to the filter",
-				MarkdownDescription: "List of nodepools matched to the filter",
+				Description:         "List of nodepools matching the filters",
+				MarkdownDescription: "List of nodepools matching the filters",
 			},
 		},
 	}
@@ -87,6 +141,7 @@ func NodepoolsDataSourceSchema(ctx context.Context) schema.Schema {
 
 type NodepoolsModel struct {
 	Filter    FilterValue  `tfsdk:"filter"`
+	Filters   types.List   `tfsdk:"filters"`
 	Id        types.String `tfsdk:"id"`
 	Nodepools types.List   `tfsdk:"nodepools"`
 }
@@ -479,14 +534,14 @@ func (v FilterValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	}
 }
 
-var _ basetypes.ObjectTypable = NodepoolsType{}
+var _ basetypes.ObjectTypable = FiltersType{}
 
-type NodepoolsType struct {
+type FiltersType struct {
 	basetypes.ObjectType
 }
 
-func (t NodepoolsType) Equal(o attr.Type) bool {
-	other, ok := o.(NodepoolsType)
+func (t FiltersType) Equal(o attr.Type) bool {
+	other, ok := o.(FiltersType)
 
 	if !ok {
 		return false
@@ -495,113 +550,113 @@ func (t NodepoolsType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t NodepoolsType) String() string {
-	return "NodepoolsType"
+func (t FiltersType) String() string {
+	return "FiltersType"
 }
 
-func (t NodepoolsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t FiltersType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	cloudProviderNameAttribute, ok := attributes["cloud_provider_name"]
+	nameAttribute, ok := attributes["name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`cloud_provider_name is missing from object`)
+			`name is missing from object`)
 
 		return nil, diags
 	}
 
-	cloudProviderNameVal, ok := cloudProviderNameAttribute.(basetypes.StringValue)
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`cloud_provider_name expected to be basetypes.StringValue, was: %T`, cloudProviderNameAttribute))
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	cloudProviderUuidAttribute, ok := attributes["cloud_provider_uuid"]
+	operatorAttribute, ok := attributes["operator"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`cloud_provider_uuid is missing from object`)
+			`operator is missing from object`)
 
 		return nil, diags
 	}
 
-	cloudProviderUuidVal, ok := cloudProviderUuidAttribute.(basetypes.StringValue)
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`cloud_provider_uuid expected to be basetypes.StringValue, was: %T`, cloudProviderUuidAttribute))
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
 	}
 
-	idAttribute, ok := attributes["id"]
+	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`id is missing from object`)
+			`regexes is missing from object`)
 
 		return nil, diags
 	}
 
-	idVal, ok := idAttribute.(basetypes.StringValue)
+	regexesVal, ok := regexesAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+			fmt.Sprintf(`regexes expected to be basetypes.SetValue, was: %T`, regexesAttribute))
 	}
 
-	nameAttribute, ok := attributes["name"]
+	valuesAttribute, ok := attributes["values"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`name is missing from object`)
+			`values is missing from object`)
 
 		return nil, diags
 	}
 
-	nameVal, ok := nameAttribute.(basetypes.StringValue)
+	valuesVal, ok := valuesAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+			fmt.Sprintf(`values expected to be basetypes.SetValue, was: %T`, valuesAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return NodepoolsValue{
-		CloudProviderName: cloudProviderNameVal,
-		CloudProviderUuid: cloudProviderUuidVal,
-		Id:                idVal,
-		Name:              nameVal,
-		state:             attr.ValueStateKnown,
+	return FiltersValue{
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
-func NewNodepoolsValueNull() NodepoolsValue {
-	return NodepoolsValue{
+func NewFiltersValueNull() FiltersValue {
+	return FiltersValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewNodepoolsValueUnknown() NodepoolsValue {
-	return NodepoolsValue{
+func NewFiltersValueUnknown() FiltersValue {
+	return FiltersValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewNodepoolsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (NodepoolsValue, diag.Diagnostics) {
+func NewFiltersValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (FiltersValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -612,11 +667,11 @@ func NewNodepoolsValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !ok {
 			diags.AddError(
-				"Missing NodepoolsValue Attribute Value",
-				"While creating a NodepoolsValue value, a missing attribute value was detected. "+
-					"A NodepoolsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing FiltersValue Attribute Value",
+				"While creating a FiltersValue value, a missing attribute value was detected. "+
+					"A FiltersValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("NodepoolsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("FiltersValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -624,12 +679,12 @@ func NewNodepoolsValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid NodepoolsValue Attribute Type",
-				"While creating a NodepoolsValue value, an invalid attribute value was detected. "+
-					"A NodepoolsValue must use a matching attribute type for the value. "+
+				"Invalid FiltersValue Attribute Type",
+				"While creating a FiltersValue value, an invalid attribute value was detected. "+
+					"A FiltersValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("NodepoolsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("NodepoolsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("FiltersValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("FiltersValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -639,106 +694,106 @@ func NewNodepoolsValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !ok {
 			diags.AddError(
-				"Extra NodepoolsValue Attribute Value",
-				"While creating a NodepoolsValue value, an extra attribute value was detected. "+
-					"A NodepoolsValue must not contain values beyond the expected attribute types. "+
+				"Extra FiltersValue Attribute Value",
+				"While creating a FiltersValue value, an extra attribute value was detected. "+
+					"A FiltersValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra NodepoolsValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra FiltersValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewNodepoolsValueUnknown(), diags
+		return NewFiltersValueUnknown(), diags
 	}
 
-	cloudProviderNameAttribute, ok := attributes["cloud_provider_name"]
+	nameAttribute, ok := attributes["name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`cloud_provider_name is missing from object`)
+			`name is missing from object`)
 
-		return NewNodepoolsValueUnknown(), diags
+		return NewFiltersValueUnknown(), diags
 	}
 
-	cloudProviderNameVal, ok := cloudProviderNameAttribute.(basetypes.StringValue)
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`cloud_provider_name expected to be basetypes.StringValue, was: %T`, cloudProviderNameAttribute))
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	cloudProviderUuidAttribute, ok := attributes["cloud_provider_uuid"]
+	operatorAttribute, ok := attributes["operator"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`cloud_provider_uuid is missing from object`)
+			`operator is missing from object`)
 
-		return NewNodepoolsValueUnknown(), diags
+		return NewFiltersValueUnknown(), diags
 	}
 
-	cloudProviderUuidVal, ok := cloudProviderUuidAttribute.(basetypes.StringValue)
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`cloud_provider_uuid expected to be basetypes.StringValue, was: %T`, cloudProviderUuidAttribute))
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
 	}
 
-	idAttribute, ok := attributes["id"]
+	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`id is missing from object`)
+			`regexes is missing from object`)
 
-		return NewNodepoolsValueUnknown(), diags
+		return NewFiltersValueUnknown(), diags
 	}
 
-	idVal, ok := idAttribute.(basetypes.StringValue)
+	regexesVal, ok := regexesAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+			fmt.Sprintf(`regexes expected to be basetypes.SetValue, was: %T`, regexesAttribute))
 	}
 
-	nameAttribute, ok := attributes["name"]
+	valuesAttribute, ok := attributes["values"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`name is missing from object`)
+			`values is missing from object`)
 
-		return NewNodepoolsValueUnknown(), diags
+		return NewFiltersValueUnknown(), diags
 	}
 
-	nameVal, ok := nameAttribute.(basetypes.StringValue)
+	valuesVal, ok := valuesAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+			fmt.Sprintf(`values expected to be basetypes.SetValue, was: %T`, valuesAttribute))
 	}
 
 	if diags.HasError() {
-		return NewNodepoolsValueUnknown(), diags
+		return NewFiltersValueUnknown(), diags
 	}
 
-	return NodepoolsValue{
-		CloudProviderName: cloudProviderNameVal,
-		CloudProviderUuid: cloudProviderUuidVal,
-		Id:                idVal,
-		Name:              nameVal,
-		state:             attr.ValueStateKnown,
+	return FiltersValue{
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
-func NewNodepoolsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) NodepoolsValue {
-	object, diags := NewNodepoolsValue(attributeTypes, attributes)
+func NewFiltersValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) FiltersValue {
+	object, diags := NewFiltersValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -752,15 +807,15 @@ func NewNodepoolsValueMust(attributeTypes map[string]attr.Type, attributes map[s
 				diagnostic.Detail()))
 		}
 
-		panic("NewNodepoolsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewFiltersValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t NodepoolsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t FiltersType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewNodepoolsValueNull(), nil
+		return NewFiltersValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -768,11 +823,11 @@ func (t NodepoolsType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 	}
 
 	if !in.IsKnown() {
-		return NewNodepoolsValueUnknown(), nil
+		return NewFiltersValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewNodepoolsValueNull(), nil
+		return NewFiltersValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -795,33 +850,37 @@ func (t NodepoolsType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 		attributes[k] = a
 	}
 
-	return NewNodepoolsValueMust(NodepoolsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewFiltersValueMust(FiltersValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t NodepoolsType) ValueType(ctx context.Context) attr.Value {
-	return NodepoolsValue{}
+func (t FiltersType) ValueType(ctx context.Context) attr.Value {
+	return FiltersValue{}
 }
 
-var _ basetypes.ObjectValuable = NodepoolsValue{}
+var _ basetypes.ObjectValuable = FiltersValue{}
 
-type NodepoolsValue struct {
-	CloudProviderName basetypes.StringValue `tfsdk:"cloud_provider_name"`
-	CloudProviderUuid basetypes.StringValue `tfsdk:"cloud_provider_uuid"`
-	Id                basetypes.StringValue `tfsdk:"id"`
-	Name              basetypes.StringValue `tfsdk:"name"`
-	state             attr.ValueState
+type FiltersValue struct {
+	Name     basetypes.StringValue `tfsdk:"name"`
+	Operator basetypes.StringValue `tfsdk:"operator"`
+	Regexes  basetypes.SetValue    `tfsdk:"regexes"`
+	Values   basetypes.SetValue    `tfsdk:"values"`
+	state    attr.ValueState
 }
 
-func (v NodepoolsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	attrTypes := make(map[string]tftypes.Type, 4)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["cloud_provider_name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["cloud_provider_uuid"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["operator"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["regexes"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["values"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
@@ -829,37 +888,37 @@ func (v NodepoolsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 	case attr.ValueStateKnown:
 		vals := make(map[string]tftypes.Value, 4)
 
-		val, err = v.CloudProviderName.ToTerraformValue(ctx)
+		val, err = v.Name.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["cloud_provider_name"] = val
+		vals["name"] = val
 
-		val, err = v.CloudProviderUuid.ToTerraformValue(ctx)
+		val, err = v.Operator.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["cloud_provider_uuid"] = val
+		vals["operator"] = val
 
-		val, err = v.Id.ToTerraformValue(ctx)
+		val, err = v.Regexes.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["id"] = val
+		vals["regexes"] = val
 
-		val, err = v.Name.ToTerraformValue(ctx)
+		val, err = v.Values.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["name"] = val
+		vals["values"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -875,40 +934,78 @@ func (v NodepoolsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 	}
 }
 
-func (v NodepoolsValue) IsNull() bool {
+func (v FiltersValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v NodepoolsValue) IsUnknown() bool {
+func (v FiltersValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v NodepoolsValue) String() string {
-	return "NodepoolsValue"
+func (v FiltersValue) String() string {
+	return "FiltersValue"
 }
 
-func (v NodepoolsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	regexesVal, d := types.SetValue(types.StringType, v.Regexes.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
+			"regexes": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"values": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	valuesVal, d := types.SetValue(types.StringType, v.Values.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
+			"regexes": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"values": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"cloud_provider_name": basetypes.StringType{},
-			"cloud_provider_uuid": basetypes.StringType{},
-			"id":                  basetypes.StringType{},
-			"name":                basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
+			"regexes": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"values": basetypes.SetType{
+				ElemType: types.StringType,
+			},
 		},
 		map[string]attr.Value{
-			"cloud_provider_name": v.CloudProviderName,
-			"cloud_provider_uuid": v.CloudProviderUuid,
-			"id":                  v.Id,
-			"name":                v.Name,
+			"name":     v.Name,
+			"operator": v.Operator,
+			"regexes":  regexesVal,
+			"values":   valuesVal,
 		})
 
 	return objVal, diags
 }
 
-func (v NodepoolsValue) Equal(o attr.Value) bool {
-	other, ok := o.(NodepoolsValue)
+func (v FiltersValue) Equal(o attr.Value) bool {
+	other, ok := o.(FiltersValue)
 
 	if !ok {
 		return false
@@ -922,38 +1019,681 @@ func (v NodepoolsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.CloudProviderName.Equal(other.CloudProviderName) {
+	if !v.Name.Equal(other.Name) {
 		return false
 	}
 
-	if !v.CloudProviderUuid.Equal(other.CloudProviderUuid) {
+	if !v.Operator.Equal(other.Operator) {
 		return false
 	}
 
-	if !v.Id.Equal(other.Id) {
+	if !v.Regexes.Equal(other.Regexes) {
 		return false
 	}
 
-	if !v.Name.Equal(other.Name) {
+	if !v.Values.Equal(other.Values) {
 		return false
 	}
 
 	return true
 }
 
-func (v NodepoolsValue) Type(ctx context.Context) attr.Type {
-	return NodepoolsType{
+func (v FiltersValue) Type(ctx context.Context) attr.Type {
+	return FiltersType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v NodepoolsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v FiltersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"cloud_provider_name": basetypes.StringType{},
-		"cloud_provider_uuid": basetypes.StringType{},
-		"id":                  basetypes.StringType{},
-		"name":                basetypes.StringType{},
+		"name":     basetypes.StringType{},
+		"operator": basetypes.StringType{},
+		"regexes": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"values": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = NodepoolsType{}
+
+type NodepoolsType struct {
+	basetypes.ObjectType
+}
+
+func (t NodepoolsType) Equal(o attr.Type) bool {
+	other, ok := o.(NodepoolsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t NodepoolsType) String() string {
+	return "NodepoolsType"
+}
+
+func (t NodepoolsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	cloudProviderNameAttribute, ok := attributes["cloud_provider_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cloud_provider_name is missing from object`)
+
+		return nil, diags
+	}
+
+	cloudProviderNameVal, ok := cloudProviderNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cloud_provider_name expected to be basetypes.StringValue, was: %T`, cloudProviderNameAttribute))
+	}
+
+	cloudProviderUuidAttribute, ok := attributes["cloud_provider_uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cloud_provider_uuid is missing from object`)
+
+		return nil, diags
+	}
+
+	cloudProviderUuidVal, ok := cloudProviderUuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cloud_provider_uuid expected to be basetypes.StringValue, was: %T`, cloudProviderUuidAttribute))
+	}
+
+	clusterIdsAttribute, ok := attributes["cluster_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cluster_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	clusterIdsVal, ok := clusterIdsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cluster_ids expected to be basetypes.ListValue, was: %T`, clusterIdsAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	nodeIdsAttribute, ok := attributes["node_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`node_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	nodeIdsVal, ok := nodeIdsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`node_ids expected to be basetypes.ListValue, was: %T`, nodeIdsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return NodepoolsValue{
+		CloudProviderName: cloudProviderNameVal,
+		CloudProviderUuid: cloudProviderUuidVal,
+		ClusterIds:        clusterIdsVal,
+		Id:                idVal,
+		Name:              nameVal,
+		NodeIds:           nodeIdsVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNodepoolsValueNull() NodepoolsValue {
+	return NodepoolsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewNodepoolsValueUnknown() NodepoolsValue {
+	return NodepoolsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewNodepoolsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (NodepoolsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing NodepoolsValue Attribute Value",
+				"While creating a NodepoolsValue value, a missing attribute value was detected. "+
+					"A NodepoolsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NodepoolsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid NodepoolsValue Attribute Type",
+				"While creating a NodepoolsValue value, an invalid attribute value was detected. "+
+					"A NodepoolsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NodepoolsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("NodepoolsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra NodepoolsValue Attribute Value",
+				"While creating a NodepoolsValue value, an extra attribute value was detected. "+
+					"A NodepoolsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra NodepoolsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	cloudProviderNameAttribute, ok := attributes["cloud_provider_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cloud_provider_name is missing from object`)
+
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	cloudProviderNameVal, ok := cloudProviderNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cloud_provider_name expected to be basetypes.StringValue, was: %T`, cloudProviderNameAttribute))
+	}
+
+	cloudProviderUuidAttribute, ok := attributes["cloud_provider_uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cloud_provider_uuid is missing from object`)
+
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	cloudProviderUuidVal, ok := cloudProviderUuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cloud_provider_uuid expected to be basetypes.StringValue, was: %T`, cloudProviderUuidAttribute))
+	}
+
+	clusterIdsAttribute, ok := attributes["cluster_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cluster_ids is missing from object`)
+
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	clusterIdsVal, ok := clusterIdsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cluster_ids expected to be basetypes.ListValue, was: %T`, clusterIdsAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	nodeIdsAttribute, ok := attributes["node_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`node_ids is missing from object`)
+
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	nodeIdsVal, ok := nodeIdsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`node_ids expected to be basetypes.ListValue, was: %T`, nodeIdsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewNodepoolsValueUnknown(), diags
+	}
+
+	return NodepoolsValue{
+		CloudProviderName: cloudProviderNameVal,
+		CloudProviderUuid: cloudProviderUuidVal,
+		ClusterIds:        clusterIdsVal,
+		Id:                idVal,
+		Name:              nameVal,
+		NodeIds:           nodeIdsVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNodepoolsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) NodepoolsValue {
+	object, diags := NewNodepoolsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewNodepoolsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t NodepoolsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewNodepoolsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewNodepoolsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewNodepoolsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewNodepoolsValueMust(NodepoolsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t NodepoolsType) ValueType(ctx context.Context) attr.Value {
+	return NodepoolsValue{}
+}
+
+var _ basetypes.ObjectValuable = NodepoolsValue{}
+
+type NodepoolsValue struct {
+	CloudProviderName basetypes.StringValue `tfsdk:"cloud_provider_name"`
+	CloudProviderUuid basetypes.StringValue `tfsdk:"cloud_provider_uuid"`
+	ClusterIds        basetypes.ListValue   `tfsdk:"cluster_ids"`
+	Id                basetypes.StringValue `tfsdk:"id"`
+	Name              basetypes.StringValue `tfsdk:"name"`
+	NodeIds           basetypes.ListValue   `tfsdk:"node_ids"`
+	state             attr.ValueState
+}
+
+func (v NodepoolsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 6)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["cloud_provider_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cloud_provider_uuid"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cluster_ids"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["node_ids"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 6)
+
+		val, err = v.CloudProviderName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cloud_provider_name"] = val
+
+		val, err = v.CloudProviderUuid.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cloud_provider_uuid"] = val
+
+		val, err = v.ClusterIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cluster_ids"] = val
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.NodeIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["node_ids"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v NodepoolsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v NodepoolsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v NodepoolsValue) String() string {
+	return "NodepoolsValue"
+}
+
+func (v NodepoolsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	clusterIdsVal, d := types.ListValue(types.StringType, v.ClusterIds.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"cloud_provider_name": basetypes.StringType{},
+			"cloud_provider_uuid": basetypes.StringType{},
+			"cluster_ids": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"id":   basetypes.StringType{},
+			"name": basetypes.StringType{},
+			"node_ids": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	nodeIdsVal, d := types.ListValue(types.StringType, v.NodeIds.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"cloud_provider_name": basetypes.StringType{},
+			"cloud_provider_uuid": basetypes.StringType{},
+			"cluster_ids": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"id":   basetypes.StringType{},
+			"name": basetypes.StringType{},
+			"node_ids": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"cloud_provider_name": basetypes.StringType{},
+			"cloud_provider_uuid": basetypes.StringType{},
+			"cluster_ids": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"id":   basetypes.StringType{},
+			"name": basetypes.StringType{},
+			"node_ids": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		},
+		map[string]attr.Value{
+			"cloud_provider_name": v.CloudProviderName,
+			"cloud_provider_uuid": v.CloudProviderUuid,
+			"cluster_ids":         clusterIdsVal,
+			"id":                  v.Id,
+			"name":                v.Name,
+			"node_ids":            nodeIdsVal,
+		})
+
+	return objVal, diags
+}
+
+func (v NodepoolsValue) Equal(o attr.Value) bool {
+	other, ok := o.(NodepoolsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.CloudProviderName.Equal(other.CloudProviderName) {
+		return false
+	}
+
+	if !v.CloudProviderUuid.Equal(other.CloudProviderUuid) {
+		return false
+	}
+
+	if !v.ClusterIds.Equal(other.ClusterIds) {
+		return false
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.NodeIds.Equal(other.NodeIds) {
+		return false
+	}
+
+	return true
+}
+
+func (v NodepoolsValue) Type(ctx context.Context) attr.Type {
+	return NodepoolsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v NodepoolsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"cloud_provider_name": basetypes.StringType{},
+		"cloud_provider_uuid": basetypes.StringType{},
+		"cluster_ids": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"id":   basetypes.StringType{},
+		"name": basetypes.StringType{},
+		"node_ids": basetypes.ListType{
+			ElemType: types.StringType,
+		},
 	}
 }
@@ -53,6 +53,14 @@ func NodesDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "Name of the attribute on which this filter should be applied.",
 							MarkdownDescription: "Name of the attribute on which this filter should be applied.",
 						},
+						"operator": schema.StringAttribute{
+							Optional:            true,
+							Description:         "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							MarkdownDescription: "How the values are matched: exact (default), prefix, regex or not. With regex the values are treated as regexes. With not the filter matches when none of the values and regexes match",
+							Validators: []validator.String{
+								stringvalidator.OneOf("exact", "prefix", "regex", "not"),
+							},
+						},
 						"regexes": schema.SetAttribute{
 							ElementType:         types.StringType,
 							Optional:            true,
@@ -73,8 +81,8 @@ func NodesDataSourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 				Optional:            true,
-				Description:         "List of filters",
-				MarkdownDescription: "List of filters",
+				Description:         "List of filters. An item is selected if it matches all the filters.",
+				MarkdownDescription: "List of filters. An item is selected if it matches all the filters.",
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -595,6 +603,24 @@ func (t FiltersType) ValueFromObject(ctx context.Context, in basetypes.ObjectVal
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return nil, diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
 	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
@@ -636,10 +662,11 @@ func (t FiltersType) ValueFromObject(ctx context.Context, in basetypes.ObjectVal
 	}
 
 	return FiltersValue{
-		Name:    nameVal,
-		Regexes: regexesVal,
-		Values:  valuesVal,
-		state:   attr.ValueStateKnown,
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
@@ -724,6 +751,24 @@ func NewFiltersValue(attributeTypes map[string]attr.Type, attributes map[string]
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return NewFiltersValueUnknown(), diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
 	regexesAttribute, ok := attributes["regexes"]
 
 	if !ok {
@@ -765,10 +810,11 @@ func NewFiltersValue(attributeTypes map[string]attr.Type, attributes map[string]
 	}
 
 	return FiltersValue{
-		Name:    nameVal,
-		Regexes: regexesVal,
-		Values:  valuesVal,
-		state:   attr.ValueStateKnown,
+		Name:     nameVal,
+		Operator: operatorVal,
+		Regexes:  regexesVal,
+		Values:   valuesVal,
+		state:    attr.ValueStateKnown,
 	}, diags
 }
 
@@ -840,19 +886,21 @@ func (t FiltersType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = FiltersValue{}
 
 type FiltersValue struct {
-	Name    basetypes.StringValue `tfsdk:"name"`
-	Regexes basetypes.SetValue    `tfsdk:"regexes"`
-	Values  basetypes.SetValue    `tfsdk:"values"`
-	state   attr.ValueState
+	Name     basetypes.StringValue `tfsdk:"name"`
+	Operator basetypes.StringValue `tfsdk:"operator"`
+	Regexes  basetypes.SetValue    `tfsdk:"regexes"`
+	Values   basetypes.SetValue    `tfsdk:"values"`
+	state    attr.ValueState
 }
 
 func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
+	attrTypes := make(map[string]tftypes.Type, 4)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["operator"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["regexes"] = basetypes.SetType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
@@ -864,7 +912,7 @@ func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
+		vals := make(map[string]tftypes.Value, 4)
 
 		val, err = v.Name.ToTerraformValue(ctx)
 
@@ -874,6 +922,14 @@ func (v FiltersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 
 		vals["name"] = val
 
+		val, err = v.Operator.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["operator"] = val
+
 		val, err = v.Regexes.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -925,7 +981,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -941,7 +998,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -953,7 +1011,8 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"name": basetypes.StringType{},
+			"name":     basetypes.StringType{},
+			"operator": basetypes.StringType{},
 			"regexes": basetypes.SetType{
 				ElemType: types.StringType,
 			},
@@ -962,9 +1021,10 @@ func (v FiltersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 			},
 		},
 		map[string]attr.Value{
-			"name":    v.Name,
-			"regexes": regexesVal,
-			"values":  valuesVal,
+			"name":     v.Name,
+			"operator": v.Operator,
+			"regexes":  regexesVal,
+			"values":   valuesVal,
 		})
 
 	return objVal, diags
@@ -989,6 +1049,10 @@ func (v FiltersValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.Operator.Equal(other.Operator) {
+		return false
+	}
+
 	if !v.Regexes.Equal(other.Regexes) {
 		return false
 	}
@@ -1010,7 +1074,8 @@ func (v FiltersValue) Type(ctx context.Context) attr.Type {
 
 func (v FiltersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"name": basetypes.StringType{},
+		"name":     basetypes.StringType{},
+		"operator": basetypes.StringType{},
 		"regexes": basetypes.SetType{
 			ElemType: types.StringType,
 		},
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FilterOperator decides how the values of a filter are matched against the attribute
+type FilterOperator string
+
+const (
+	// FilterOperatorExact matches the attributes equal to one of the values
+	FilterOperatorExact FilterOperator = "exact"
+	// FilterOperatorPrefix matches the attributes starting with one of the values
+	FilterOperatorPrefix FilterOperator = "prefix"
+	// FilterOperatorRegex matches the attributes matching one of the values as a regex
+	FilterOperatorRegex FilterOperator = "regex"
+	// FilterOperatorNot matches the attributes that the exact operator does not match
+	FilterOperatorNot FilterOperator = "not"
+)
+
+// Filter selects the items whose attribute matches any of the values or regexes. An item
+// is selected only if it matches every filter.
+type Filter struct {
+	Name     string
+	Values   []string
+	Regexes  []string
+	Operator FilterOperator
+}
+
+// filterAttribute returns the values of the attribute of the item, an item matches a filter
+// if any of the values matches. Attributes such as roles have more than one value.
+type filterAttribute[T any] func(item T) []string
+
+// filterAttributes are the attributes that a data source can be filtered on
+type filterAttributes[T any] struct {
+	attributes map[string]filterAttribute[T]
+	// keyed are filtered on by <name>:<key>, e.g. tags:environment
+	keyed map[string]func(item T, key string) []string
+}
+
+// names returns the valid filter names, the keyed attributes as <name>:<key>
+func (a filterAttributes[T]) names() []string {
+	names := []string{}
+	for name := range a.attributes {
+		names = append(names, name)
+	}
+	for name := range a.keyed {
+		names = append(names, name+":<key>")
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (a filterAttributes[T]) get(name string) (filterAttribute[T], error) {
+	if attribute, ok := a.attributes[name]; ok {
+		return attribute, nil
+	}
+	if keyedName, key, found := strings.Cut(name, ":"); found && key != "" {
+		if attribute, ok := a.keyed[keyedName]; ok {
+			return func(item T) []string { return attribute(item, key) }, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown filter %v, allowed filter names are: %v", name, strings.Join(a.names(), ", "))
+}
+
+// filterMatcher is a filter ready to be matched against the items
+type filterMatcher[T any] struct {
+	attribute filterAttribute[T]
+	values    []string
+	regexes   []*regexp.Regexp
+	operator  FilterOperator
+}
+
+func (m filterMatcher[T]) matches(item T) bool {
+	matched := false
+	for _, value := range m.attribute(item) {
+		if m.matchesValue(value) {
+			matched = true
+			break
+		}
+	}
+	if m.operator == FilterOperatorNot {
+		return !matched
+	}
+	return matched
+}
+
+func (m filterMatcher[T]) matchesValue(value string) bool {
+	for _, filterValue := range m.values {
+		switch m.operator {
+		case FilterOperatorPrefix:
+			if strings.HasPrefix(value, filterValue) {
+				return true
+			}
+		default:
+			if value == filterValue {
+				return true
+			}
+		}
+	}
+	for _, regex := range m.regexes {
+		if regex.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFilters returns the items that match all the filters, in their original order. Each
+// item is returned at most once however many values or regexes it matches.
+func applyFilters[T any](items []T, filters []Filter, attributes filterAttributes[T]) ([]T, error) {
+	matchers := []filterMatcher[T]{}
+	for _, filter := range filters {
+		attribute, err := attributes.get(filter.Name)
+		if err != nil {
+			return nil, err
+		}
+		matcher := filterMatcher[T]{attribute: attribute, operator: filter.Operator}
+		regexes := filter.Regexes
+		switch filter.Operator {
+		case "", FilterOperatorExact, FilterOperatorPrefix, FilterOperatorNot:
+			matcher.values = filter.Values
+		case FilterOperatorRegex:
+			regexes = append(append([]string{}, filter.Values...), filter.Regexes...)
+		default:
+			return nil, fmt.Errorf("unknown operator %v of the filter %v, allowed operators are: %v, %v, %v, %v", filter.Operator,
+				filter.Name, FilterOperatorExact, FilterOperatorPrefix, FilterOperatorRegex, FilterOperatorNot)
+		}
+		for _, expr := range regexes {
+			regex, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex %v of the filter %v: %w", expr, filter.Name, err)
+			}
+			matcher.regexes = append(matcher.regexes, regex)
+		}
+		matchers = append(matchers, matcher)
+	}
+	filteredItems := []T{}
+	for _, item := range items {
+		matchesAll := true
+		for _, matcher := range matchers {
+			if !matcher.matches(item) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			filteredItems = append(filteredItems, item)
+		}
+	}
+	return filteredItems, nil
+}
+
+// lookupOne returns the only item whose attribute equals value. Finding no item or several items
+// is an error on the attribute of the data source used to look up the item.
+func lookupOne[T any](items []T, attributes filterAttributes[T], kind string, attributeName string, value string) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var item T
+	matches, err := applyFilters(items, []Filter{{Name: attributeName, Values: []string{value}}}, attributes)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to find %v", kind), err.Error())
+		return item, diags
+	}
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(path.Root(attributeName), fmt.Sprintf("%v%v not found", strings.ToUpper(kind[:1]), kind[1:]),
+			fmt.Sprintf("No %v found with %v %v", kind, attributeName, value))
+	case 1:
+		item = matches[0]
+	default:
+		diags.AddAttributeError(path.Root(attributeName), fmt.Sprintf("Multiple %vs found", kind),
+			fmt.Sprintf("Found %v %vs with %v %v, use id to select one of them", len(matches), kind, attributeName, value))
+	}
+	return item, diags
+}
+
+// filterValues is the list or set of values or regexes of a filter
+type filterValues interface {
+	IsNull() bool
+	IsUnknown() bool
+	ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics
+}
+
+// newFilter converts the filter attributes of a data source to a Filter
+func newFilter(ctx context.Context, name types.String, values filterValues, regexes filterValues, operator types.String) (Filter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filter := Filter{
+		Name:     name.ValueString(),
+		Operator: FilterOperator(operator.ValueString()),
+	}
+	if !values.IsNull() && !values.IsUnknown() {
+		diags.Append(values.ElementsAs(ctx, &filter.Values, false)...)
+	}
+	if !regexes.IsNull() && !regexes.IsUnknown() {
+		diags.Append(regexes.ElementsAs(ctx, &filter.Regexes, false)...)
+	}
+	return filter, diags
+}
+
+func strAttr(value string) []string {
+	return []string{value}
+}
+
+func boolAttr(value bool) []string {
+	return []string{fmt.Sprintf("%v", value)}
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testFilterItem struct {
+	Name  string
+	Roles []string
+	Tags  map[string]string
+}
+
+var testFilterAttributes = filterAttributes[testFilterItem]{
+	attributes: map[string]filterAttribute[testFilterItem]{
+		"name":  func(item testFilterItem) []string { return strAttr(item.Name) },
+		"roles": func(item testFilterItem) []string { return item.Roles },
+	},
+	keyed: map[string]func(item testFilterItem, key string) []string{
+		"tags": func(item testFilterItem, key string) []string {
+			if value, ok := item.Tags[key]; ok {
+				return []string{value}
+			}
+			return nil
+		},
+	},
+}
+
+var testFilterItems = []testFilterItem{
+	{Name: "master-01", Roles: []string{"pf9-kube", "pf9-ostackhost"}, Tags: map[string]string{"env": "prod"}},
+	{Name: "worker-01", Roles: []string{"pf9-kube"}, Tags: map[string]string{"env": "dev"}},
+	{Name: "worker-02", Roles: []string{}, Tags: map[string]string{}},
+}
+
+func TestApplyFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []Filter
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "no filters",
+			want: []string{"master-01", "worker-01", "worker-02"},
+		},
+		{
+			name:    "exact operator by default",
+			filters: []Filter{{Name: "name", Values: []string{"worker-01"}}},
+			want:    []string{"worker-01"},
+		},
+		{
+			name:    "exact operator",
+			filters: []Filter{{Name: "name", Values: []string{"worker"}, Operator: FilterOperatorExact}},
+			want:    []string{},
+		},
+		{
+			name:    "prefix operator",
+			filters: []Filter{{Name: "name", Values: []string{"worker"}, Operator: FilterOperatorPrefix}},
+			want:    []string{"worker-01", "worker-02"},
+		},
+		{
+			name:    "regex operator",
+			filters: []Filter{{Name: "name", Values: []string{"^master-[0-9]+$"}, Operator: FilterOperatorRegex}},
+			want:    []string{"master-01"},
+		},
+		{
+			name:    "not operator",
+			filters: []Filter{{Name: "name", Values: []string{"worker-01"}, Operator: FilterOperatorNot}},
+			want:    []string{"master-01", "worker-02"},
+		},
+		{
+			name: "not operator with regexes",
+			filters: []Filter{{Name: "name", Values: []string{"master-01"}, Regexes: []string{"-02$"},
+				Operator: FilterOperatorNot}},
+			want: []string{"worker-01"},
+		},
+		{
+			name:    "OR within a filter",
+			filters: []Filter{{Name: "name", Values: []string{"master-01", "worker-02"}}},
+			want:    []string{"master-01", "worker-02"},
+		},
+		{
+			name: "AND across filters",
+			filters: []Filter{
+				{Name: "roles", Values: []string{"pf9-kube"}},
+				{Name: "name", Values: []string{"worker"}, Operator: FilterOperatorPrefix},
+			},
+			want: []string{"worker-01"},
+		},
+		{
+			name:    "multi-valued attribute matches any value",
+			filters: []Filter{{Name: "roles", Values: []string{"pf9-ostackhost"}}},
+			want:    []string{"master-01"},
+		},
+		{
+			name:    "item matching a value and a regex is returned once",
+			filters: []Filter{{Name: "name", Values: []string{"worker-01"}, Regexes: []string{"^worker"}}},
+			want:    []string{"worker-01", "worker-02"},
+		},
+		{
+			name:    "keyed attribute",
+			filters: []Filter{{Name: "tags:env", Values: []string{"prod"}}},
+			want:    []string{"master-01"},
+		},
+		{
+			name:    "keyed attribute missing on the item",
+			filters: []Filter{{Name: "tags:owner", Values: []string{"prod"}}},
+			want:    []string{},
+		},
+		{
+			name:    "unknown filter name",
+			filters: []Filter{{Name: "color", Values: []string{"blue"}}},
+			wantErr: "unknown filter color, allowed filter names are: name, roles, tags:<key>",
+		},
+		{
+			name:    "keyed filter without a key",
+			filters: []Filter{{Name: "tags:", Values: []string{"prod"}}},
+			wantErr: "unknown filter tags:",
+		},
+		{
+			name:    "unknown operator",
+			filters: []Filter{{Name: "name", Values: []string{"worker-01"}, Operator: "suffix"}},
+			wantErr: "unknown operator suffix of the filter name",
+		},
+		{
+			name:    "invalid regex",
+			filters: []Filter{{Name: "name", Regexes: []string{"worker-("}}},
+			wantErr: "invalid regex worker-( of the filter name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := applyFilters(testFilterItems, tt.filters, testFilterAttributes)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("applyFilters() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyFilters() unexpected error: %v", err)
+			}
+			names := []string{}
+			for _, item := range items {
+				names = append(names, item.Name)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("applyFilters() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
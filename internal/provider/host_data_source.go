@@ -33,7 +33,7 @@ func (d *hostDataSource) Configure(ctx context.Context, req datasource.Configure
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *hostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -53,6 +53,20 @@ func (d *hostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		resp.Diagnostics.AddError("Failed to authenticate", err.Error())
 		return
 	}
+	if !data.Hostname.IsNull() {
+		hosts, err := d.client.Resmgr().ListHosts(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list hosts", err.Error())
+			return
+		}
+		host, diags := lookupOne(hosts, hostFilterAttributes, "host", "hostname", data.Hostname.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		hostID = host.ID
+		data.Id = types.StringValue(hostID)
+	}
 	host, err := d.client.Resmgr().GetHost(ctx, hostID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get host", err.Error())
@@ -65,38 +79,75 @@ func (d *hostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		resp.Diagnostics.AddError("Failed to read extensions", err.Error())
 		return
 	}
+	facts, err := readHostFacts(*host)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read host facts", err.Error())
+		return
+	}
 	ifaces := []datasource_host.InterfacesValue{}
-	for key, val := range extensions.Interfaces.Data.InterfaceIP {
-		ifaceObjVal, diags := datasource_host.InterfacesValue{
-			Name: types.StringValue(key),
-			Ip:   types.StringValue(val),
-		}.ToObjectValue(ctx)
+	for _, ifaceAttributes := range facts.interfacesAttributes(extensions.Interfaces.Data.InterfaceIP) {
+		ifaceVal, diags := datasource_host.NewInterfacesValue(datasource_host.InterfacesValue{}.AttributeTypes(ctx), ifaceAttributes)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		ifaceVal, diags := datasource_host.NewInterfacesValue(ifaceObjVal.AttributeTypes(ctx), ifaceObjVal.Attributes())
+		ifaces = append(ifaces, ifaceVal)
+	}
+	var diags diag.Diagnostics
+	data.Interfaces, diags = types.ListValueFrom(ctx, datasource_host.InterfacesValue{}.Type(ctx), ifaces)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	volumeGroups := []datasource_host.VolumeGroupsValue{}
+	for _, volumeGroupAttributes := range facts.volumeGroupsAttributes() {
+		volumeGroup, diags := datasource_host.NewVolumeGroupsValue(datasource_host.VolumeGroupsValue{}.AttributeTypes(ctx), volumeGroupAttributes)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		ifaces = append(ifaces, ifaceVal)
+		volumeGroups = append(volumeGroups, volumeGroup)
 	}
-	var diags diag.Diagnostics
-	data.Interfaces, diags = types.ListValueFrom(ctx, datasource_host.InterfacesValue{}.Type(ctx), ifaces)
+	data.VolumeGroups, diags = types.ListValueFrom(ctx, datasource_host.VolumeGroupsValue{}.Type(ctx), volumeGroups)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if host.Info.LastResponseTime != nil {
-		if strLastRespTime, ok := host.Info.LastResponseTime.(string); ok {
-			data.LastResponseTime = types.StringValue(strLastRespTime)
-		} else {
-			data.LastResponseTime = types.StringNull()
+	blockDevices := []datasource_host.BlockDevicesValue{}
+	for _, blockDeviceAttributes := range facts.blockDevicesAttributes() {
+		blockDevice, diags := datasource_host.NewBlockDevicesValue(datasource_host.BlockDevicesValue{}.AttributeTypes(ctx), blockDeviceAttributes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-	} else {
-		data.LastResponseTime = types.StringNull()
+		blockDevices = append(blockDevices, blockDevice)
+	}
+	data.BlockDevices, diags = types.ListValueFrom(ctx, datasource_host.BlockDevicesValue{}.Type(ctx), blockDevices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Cpu = datasource_host.NewCpuValueNull()
+	if cpuAttributes := facts.cpuAttributes(); cpuAttributes != nil {
+		data.Cpu, diags = datasource_host.NewCpuValue(datasource_host.CpuValue{}.AttributeTypes(ctx), cpuAttributes)
+		resp.Diagnostics.Append(diags...)
 	}
+	data.Memory = datasource_host.NewMemoryValueNull()
+	if memoryAttributes := facts.memoryAttributes(); memoryAttributes != nil {
+		data.Memory, diags = datasource_host.NewMemoryValue(datasource_host.MemoryValue{}.AttributeTypes(ctx), memoryAttributes)
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Hypervisor = datasource_host.NewHypervisorValueNull()
+	if hypervisorAttributes := facts.hypervisorAttributes(); hypervisorAttributes != nil {
+		data.Hypervisor, diags = datasource_host.NewHypervisorValue(datasource_host.HypervisorValue{}.AttributeTypes(ctx), hypervisorAttributes)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.GpuPresent = types.BoolPointerValue(facts.GPUPresent)
+	data.TimeSkewSeconds = types.Float64PointerValue(facts.TimeSkewSeconds)
+	data.LastResponseTime = getHostLastResponseTime(host.Info.LastResponseTime)
 	data.Message = types.StringValue(host.Message)
 	data.OsFamily = types.StringValue(host.Info.OSFamily)
 	data.OsInfo = types.StringValue(host.Info.OSInfo)
@@ -111,3 +162,11 @@ func (d *hostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// getHostLastResponseTime returns the last response time of the host, null if resmgr did not report it
+func getHostLastResponseTime(lastResponseTime interface{}) types.String {
+	if strLastRespTime, ok := lastResponseTime.(string); ok {
+		return types.StringValue(strLastRespTime)
+	}
+	return types.StringNull()
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/platform9/pf9-sdk-go/pf9/resmgr"
+)
+
+// resmgrExtensions are the extensions reported by the hostagent that host.ReadExtensions() does not
+// decode. Every extension is optional, the hostagent of a host may not report all of them.
+type resmgrExtensions struct {
+	Interfaces struct {
+		Data struct {
+			InterfaceInfo map[string]struct {
+				MAC    string      `json:"mac"`
+				MTU    interface{} `json:"mtu"`
+				Ifaces []struct {
+					Addr    string `json:"addr"`
+					Netmask string `json:"netmask"`
+				} `json:"ifaces"`
+			} `json:"iface_info"`
+		} `json:"data"`
+	} `json:"interfaces"`
+	CPUInfo *struct {
+		Data struct {
+			Sockets interface{} `json:"cpu_sockets"`
+			Cores   interface{} `json:"cpu_cores"`
+			Threads struct {
+				Total interface{} `json:"total"`
+			} `json:"cpu_threads"`
+			Model struct {
+				ModelName string `json:"model_name"`
+			} `json:"cpu_model"`
+			Vendor          string `json:"cpu_vendor"`
+			VirtualPhysical string `json:"virtual/physical"`
+		} `json:"data"`
+	} `json:"cpu_info"`
+	ResourceUsage *struct {
+		Data struct {
+			Memory *struct {
+				Total     interface{} `json:"total"`
+				Available interface{} `json:"available"`
+			} `json:"memory"`
+		} `json:"data"`
+	} `json:"resource_usage"`
+	// VolumesPresent lists the LVM volume groups with their free space
+	VolumesPresent struct {
+		Data []struct {
+			Name string      `json:"name"`
+			Size interface{} `json:"size"`
+			Free interface{} `json:"free"`
+		} `json:"data"`
+	} `json:"volumes_present"`
+	// BlockDevices lists the disks and their partitions, the size is in bytes
+	BlockDevices struct {
+		Data []struct {
+			Name string      `json:"name"`
+			Type string      `json:"type"`
+			Size interface{} `json:"size"`
+		} `json:"data"`
+	} `json:"block_devices"`
+	HypervisorInfo struct {
+		Data struct {
+			HypervisorType string `json:"hypervisor_type"`
+		} `json:"data"`
+	} `json:"hypervisor_info"`
+	// NTPStatus is reported by ntpq, the offset is in milliseconds
+	NTPStatus struct {
+		Data struct {
+			Offset interface{} `json:"offset"`
+		} `json:"data"`
+	} `json:"ntp_status"`
+	GPUInfo *struct {
+		Data json.RawMessage `json:"data"`
+	} `json:"gpu_info"`
+}
+
+// hostInterfaceInfo is what the interfaces extension reports about an interface besides its IP
+type hostInterfaceInfo struct {
+	MAC     string
+	MTU     *int64
+	Netmask string
+}
+
+type hostCPU struct {
+	Sockets *int64
+	Cores   *int64
+	Threads *int64
+	Model   string
+	Vendor  string
+}
+
+type hostMemory struct {
+	TotalBytes     *int64
+	AvailableBytes *int64
+}
+
+type hostHypervisor struct {
+	Type    string
+	Virtual *bool
+}
+
+type hostVolumeGroup struct {
+	Name      string
+	SizeBytes *int64
+	FreeBytes *int64
+}
+
+type hostBlockDevice struct {
+	Name      string
+	Type      string
+	SizeBytes *int64
+}
+
+// hostFacts are the hardware facts of a host, nil or empty when the hostagent did not report them
+type hostFacts struct {
+	Interfaces      map[string]hostInterfaceInfo
+	CPU             *hostCPU
+	Memory          *hostMemory
+	VolumeGroups    []hostVolumeGroup
+	BlockDevices    []hostBlockDevice
+	Hypervisor      *hostHypervisor
+	GPUPresent      *bool
+	TimeSkewSeconds *float64
+}
+
+// readHostFacts decodes the hardware facts from the extensions of the resmgr host
+func readHostFacts(host resmgr.Host) (hostFacts, error) {
+	facts := hostFacts{Interfaces: map[string]hostInterfaceInfo{}}
+	var rawExtensions []byte
+	switch hostExtensions := interface{}(host.Extensions).(type) {
+	case nil:
+		return facts, nil
+	case string:
+		// Some resmgr versions return the extensions as a JSON encoded string
+		rawExtensions = []byte(hostExtensions)
+	case json.RawMessage:
+		rawExtensions = hostExtensions
+	default:
+		// Decoded into generic JSON values by the SDK
+		var err error
+		rawExtensions, err = json.Marshal(hostExtensions)
+		if err != nil {
+			return facts, fmt.Errorf("failed to encode extensions of the host: %w", err)
+		}
+	}
+	if len(rawExtensions) == 0 || string(rawExtensions) == "null" {
+		return facts, nil
+	}
+	var extensions resmgrExtensions
+	if err := json.Unmarshal(rawExtensions, &extensions); err != nil {
+		return facts, fmt.Errorf("failed to decode extensions of the host: %w", err)
+	}
+
+	for name, info := range extensions.Interfaces.Data.InterfaceInfo {
+		ifaceInfo := hostInterfaceInfo{MAC: info.MAC, MTU: toInt64(info.MTU)}
+		if len(info.Ifaces) > 0 {
+			ifaceInfo.Netmask = info.Ifaces[0].Netmask
+		}
+		facts.Interfaces[name] = ifaceInfo
+	}
+	if extensions.CPUInfo != nil {
+		cpuInfo := extensions.CPUInfo.Data
+		facts.CPU = &hostCPU{
+			Sockets: toInt64(cpuInfo.Sockets),
+			Cores:   toInt64(cpuInfo.Cores),
+			Threads: toInt64(cpuInfo.Threads.Total),
+			Model:   cpuInfo.Model.ModelName,
+			Vendor:  cpuInfo.Vendor,
+		}
+	}
+	hypervisorType := extensions.HypervisorInfo.Data.HypervisorType
+	if hypervisorType != "" || (extensions.CPUInfo != nil && extensions.CPUInfo.Data.VirtualPhysical != "") {
+		facts.Hypervisor = &hostHypervisor{Type: hypervisorType}
+		if extensions.CPUInfo != nil && extensions.CPUInfo.Data.VirtualPhysical != "" {
+			isVirtual := extensions.CPUInfo.Data.VirtualPhysical == "virtual"
+			facts.Hypervisor.Virtual = &isVirtual
+		}
+	}
+	if extensions.ResourceUsage != nil && extensions.ResourceUsage.Data.Memory != nil {
+		memory := extensions.ResourceUsage.Data.Memory
+		facts.Memory = &hostMemory{
+			TotalBytes:     toInt64(memory.Total),
+			AvailableBytes: toInt64(memory.Available),
+		}
+	}
+	for _, volumeGroup := range extensions.VolumesPresent.Data {
+		facts.VolumeGroups = append(facts.VolumeGroups, hostVolumeGroup{
+			Name:      volumeGroup.Name,
+			SizeBytes: toInt64(volumeGroup.Size),
+			FreeBytes: toInt64(volumeGroup.Free),
+		})
+	}
+	sort.Slice(facts.VolumeGroups, func(i, j int) bool { return facts.VolumeGroups[i].Name < facts.VolumeGroups[j].Name })
+	for _, blockDevice := range extensions.BlockDevices.Data {
+		facts.BlockDevices = append(facts.BlockDevices, hostBlockDevice{
+			Name:      blockDevice.Name,
+			Type:      blockDevice.Type,
+			SizeBytes: toInt64(blockDevice.Size),
+		})
+	}
+	sort.Slice(facts.BlockDevices, func(i, j int) bool { return facts.BlockDevices[i].Name < facts.BlockDevices[j].Name })
+	if extensions.GPUInfo != nil {
+		gpuPresent := hasGPU(extensions.GPUInfo.Data)
+		facts.GPUPresent = &gpuPresent
+	}
+	if offsetMilliseconds := toFloat64(extensions.NTPStatus.Data.Offset); offsetMilliseconds != nil {
+		offsetSeconds := *offsetMilliseconds / 1000
+		facts.TimeSkewSeconds = &offsetSeconds
+	}
+	return facts, nil
+}
+
+// hasGPU reports whether the gpu_info extension lists a GPU, it reports them as a list or an object
+func hasGPU(gpuInfo json.RawMessage) bool {
+	var gpus []interface{}
+	if err := json.Unmarshal(gpuInfo, &gpus); err == nil {
+		return len(gpus) > 0
+	}
+	var gpusByID map[string]interface{}
+	if err := json.Unmarshal(gpuInfo, &gpusByID); err == nil {
+		return len(gpusByID) > 0
+	}
+	return false
+}
+
+// interfacesAttributes returns the attributes of the interfaces with an IP, sorted by name
+func (f hostFacts) interfacesAttributes(interfaceIPs map[string]string) []map[string]attr.Value {
+	names := []string{}
+	for name := range interfaceIPs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	ifaces := []map[string]attr.Value{}
+	for _, name := range names {
+		info := f.Interfaces[name]
+		ifaces = append(ifaces, map[string]attr.Value{
+			"name":    types.StringValue(name),
+			"ip":      types.StringValue(interfaceIPs[name]),
+			"mac":     getStrOrNullIfEmpty(info.MAC),
+			"mtu":     types.Int64PointerValue(info.MTU),
+			"netmask": getStrOrNullIfEmpty(info.Netmask),
+		})
+	}
+	return ifaces
+}
+
+// cpuAttributes returns the attributes of the cpu, nil if the host did not report it
+func (f hostFacts) cpuAttributes() map[string]attr.Value {
+	if f.CPU == nil {
+		return nil
+	}
+	return map[string]attr.Value{
+		"sockets": types.Int64PointerValue(f.CPU.Sockets),
+		"cores":   types.Int64PointerValue(f.CPU.Cores),
+		"threads": types.Int64PointerValue(f.CPU.Threads),
+		"model":   getStrOrNullIfEmpty(f.CPU.Model),
+		"vendor":  getStrOrNullIfEmpty(f.CPU.Vendor),
+	}
+}
+
+// memoryAttributes returns the attributes of the memory, nil if the host did not report it
+func (f hostFacts) memoryAttributes() map[string]attr.Value {
+	if f.Memory == nil {
+		return nil
+	}
+	return map[string]attr.Value{
+		"total_bytes":     types.Int64PointerValue(f.Memory.TotalBytes),
+		"available_bytes": types.Int64PointerValue(f.Memory.AvailableBytes),
+	}
+}
+
+// hypervisorAttributes returns the attributes of the hypervisor, nil if the host did not report it
+func (f hostFacts) hypervisorAttributes() map[string]attr.Value {
+	if f.Hypervisor == nil {
+		return nil
+	}
+	return map[string]attr.Value{
+		"type":    getStrOrNullIfEmpty(f.Hypervisor.Type),
+		"virtual": types.BoolPointerValue(f.Hypervisor.Virtual),
+	}
+}
+
+func (f hostFacts) volumeGroupsAttributes() []map[string]attr.Value {
+	volumeGroups := []map[string]attr.Value{}
+	for _, volumeGroup := range f.VolumeGroups {
+		volumeGroups = append(volumeGroups, map[string]attr.Value{
+			"name":       types.StringValue(volumeGroup.Name),
+			"size_bytes": types.Int64PointerValue(volumeGroup.SizeBytes),
+			"free_bytes": types.Int64PointerValue(volumeGroup.FreeBytes),
+		})
+	}
+	return volumeGroups
+}
+
+func (f hostFacts) blockDevicesAttributes() []map[string]attr.Value {
+	blockDevices := []map[string]attr.Value{}
+	for _, blockDevice := range f.BlockDevices {
+		blockDevices = append(blockDevices, map[string]attr.Value{
+			"name":       types.StringValue(blockDevice.Name),
+			"type":       getStrOrNullIfEmpty(blockDevice.Type),
+			"size_bytes": types.Int64PointerValue(blockDevice.SizeBytes),
+		})
+	}
+	return blockDevices
+}
+
+// objectValueOrNull returns the object with the attributes, null if there are no attributes
+func objectValueOrNull(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	if attributes == nil {
+		return types.ObjectNull(attributeTypes), nil
+	}
+	return types.ObjectValue(attributeTypes, attributes)
+}
+
+// toFloat64 converts a JSON number or numeric string, nil if the value is missing or not a number
+func toFloat64(value interface{}) *float64 {
+	var number float64
+	switch v := value.(type) {
+	case float64:
+		number = v
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil
+		}
+		number = parsed
+	default:
+		return nil
+	}
+	return &number
+}
+
+func toInt64(value interface{}) *int64 {
+	number := toFloat64(value)
+	if number == nil {
+		return nil
+	}
+	intNumber := int64(*number)
+	return &intNumber
+}
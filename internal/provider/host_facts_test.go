@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/platform9/pf9-sdk-go/pf9/resmgr"
+)
+
+func int64Ptr(v int64) *int64       { return &v }
+func float64Ptr(v float64) *float64 { return &v }
+func boolPtr(v bool) *bool          { return &v }
+
+func TestReadHostFacts(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions string
+		want       hostFacts
+		wantErr    string
+	}{
+		{
+			name: "no extensions",
+			want: hostFacts{Interfaces: map[string]hostInterfaceInfo{}},
+		},
+		{
+			name:       "null extensions",
+			extensions: `null`,
+			want:       hostFacts{Interfaces: map[string]hostInterfaceInfo{}},
+		},
+		{
+			name: "all extensions",
+			extensions: `{
+				"interfaces": {"data": {"iface_info": {
+					"ens3": {"mac": "fa:16:3e:00:00:01", "mtu": 1500, "ifaces": [{"addr": "10.0.0.5", "netmask": "255.255.255.0"}]},
+					"lo": {"mac": "", "mtu": "65536", "ifaces": []}
+				}}},
+				"cpu_info": {"data": {
+					"cpu_sockets": 2, "cpu_cores": "8", "cpu_threads": {"total": 16},
+					"cpu_model": {"model_name": "Intel Xeon"}, "cpu_vendor": "GenuineIntel", "virtual/physical": "virtual"
+				}},
+				"resource_usage": {"data": {"memory": {"total": 16777216000, "available": "8388608000"}}},
+				"volumes_present": {"data": [
+					{"name": "vg1", "size": "107374182400", "free": 0},
+					{"name": "vg0", "size": 53687091200, "free": 1073741824}
+				]},
+				"block_devices": {"data": [
+					{"name": "sdb", "type": "disk", "size": "214748364800"},
+					{"name": "sda1", "type": "part", "size": 1073741824},
+					{"name": "sda", "type": "disk", "size": 107374182400}
+				]},
+				"hypervisor_info": {"data": {"hypervisor_type": "kvm"}},
+				"ntp_status": {"data": {"offset": "-12.5"}},
+				"gpu_info": {"data": [{"model": "Tesla T4"}]}
+			}`,
+			want: hostFacts{
+				Interfaces: map[string]hostInterfaceInfo{
+					"ens3": {MAC: "fa:16:3e:00:00:01", MTU: int64Ptr(1500), Netmask: "255.255.255.0"},
+					"lo":   {MTU: int64Ptr(65536)},
+				},
+				CPU: &hostCPU{
+					Sockets: int64Ptr(2),
+					Cores:   int64Ptr(8),
+					Threads: int64Ptr(16),
+					Model:   "Intel Xeon",
+					Vendor:  "GenuineIntel",
+				},
+				Memory: &hostMemory{TotalBytes: int64Ptr(16777216000), AvailableBytes: int64Ptr(8388608000)},
+				VolumeGroups: []hostVolumeGroup{
+					{Name: "vg0", SizeBytes: int64Ptr(53687091200), FreeBytes: int64Ptr(1073741824)},
+					{Name: "vg1", SizeBytes: int64Ptr(107374182400), FreeBytes: int64Ptr(0)},
+				},
+				BlockDevices: []hostBlockDevice{
+					{Name: "sda", Type: "disk", SizeBytes: int64Ptr(107374182400)},
+					{Name: "sda1", Type: "part", SizeBytes: int64Ptr(1073741824)},
+					{Name: "sdb", Type: "disk", SizeBytes: int64Ptr(214748364800)},
+				},
+				Hypervisor:      &hostHypervisor{Type: "kvm", Virtual: boolPtr(true)},
+				GPUPresent:      boolPtr(true),
+				TimeSkewSeconds: float64Ptr(-0.0125),
+			},
+		},
+		{
+			name:       "physical host without a hypervisor type",
+			extensions: `{"cpu_info": {"data": {"virtual/physical": "physical"}}}`,
+			want: hostFacts{
+				Interfaces: map[string]hostInterfaceInfo{},
+				CPU:        &hostCPU{},
+				Hypervisor: &hostHypervisor{Virtual: boolPtr(false)},
+			},
+		},
+		{
+			name:       "GPUs reported by ID",
+			extensions: `{"gpu_info": {"data": {"0000:3b:00.0": {"model": "Tesla T4"}}}}`,
+			want:       hostFacts{Interfaces: map[string]hostInterfaceInfo{}, GPUPresent: boolPtr(true)},
+		},
+		{
+			name:       "no GPU",
+			extensions: `{"gpu_info": {"data": []}}`,
+			want:       hostFacts{Interfaces: map[string]hostInterfaceInfo{}, GPUPresent: boolPtr(false)},
+		},
+		{
+			name:       "unreadable numbers",
+			extensions: `{"resource_usage": {"data": {"memory": {"total": "unknown"}}}, "ntp_status": {"data": {"offset": true}}}`,
+			want:       hostFacts{Interfaces: map[string]hostInterfaceInfo{}, Memory: &hostMemory{}},
+		},
+		{
+			name:       "invalid extensions",
+			extensions: `{"cpu_info": []}`,
+			wantErr:    "failed to decode extensions of the host",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := resmgr.Host{}
+			if tt.extensions != "" {
+				host.Extensions = json.RawMessage(tt.extensions)
+			}
+			got, err := readHostFacts(host)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("readHostFacts() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readHostFacts() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(tt.want)
+				t.Errorf("readHostFacts() = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/platform9/pf9-sdk-go/pf9/pmk"
@@ -35,7 +37,7 @@ func (d *hostsDataSource) Configure(ctx context.Context, req datasource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -59,6 +61,7 @@ func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.AddError("Failed to list hosts", err.Error())
 		return
 	}
+	filters := []Filter{}
 	if !data.Filters.IsNull() {
 		var filterValues []datasource_hosts.FiltersValue
 		diags := data.Filters.ElementsAs(ctx, &filterValues, false)
@@ -66,25 +69,30 @@ func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		for _, filter := range filterValues {
-			var values []string
-			name := filter.Name.ValueString()
-			resp.Diagnostics.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+		for _, filterValue := range filterValues {
+			filter, diags := newFilter(ctx, filterValue.Name, filterValue.Values, filterValue.Regexes, filterValue.Operator)
+			resp.Diagnostics.Append(diags...)
 			if resp.Diagnostics.HasError() {
 				return
 			}
-			var regexes []string
-			resp.Diagnostics.Append(filter.Regexes.ElementsAs(ctx, &regexes, false)...)
-			hosts, err = filterHosts(hosts, name, values, regexes)
-			if err != nil {
-				resp.Diagnostics.AddError("Failed to filter nodes", err.Error())
-				return
-			}
+			filters = append(filters, filter)
 		}
 	}
+	hosts, err = applyFilters(hosts, filters, hostFilterAttributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to filter hosts", err.Error())
+		return
+	}
 	hostIDs := make([]string, len(hosts))
+	hostsValues := make([]datasource_hosts.HostsValue, len(hosts))
 	for i, host := range hosts {
 		hostIDs[i] = host.ID
+		hostsValue, diags := resmgrHostToHostsValue(ctx, host)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		hostsValues[i] = hostsValue
 	}
 	hostIDsValue, diags := types.ListValueFrom(ctx, types.StringType, hostIDs)
 	resp.Diagnostics.Append(diags...)
@@ -92,75 +100,108 @@ func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 	data.HostIds = hostIDsValue
+	data.Hosts, diags = types.ListValueFrom(ctx, datasource_hosts.HostsValue{}.Type(ctx), hostsValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func filterHosts(hosts []resmgr.Host, name string, values []string, regexes []string) ([]resmgr.Host, error) {
-	var filteredHosts []resmgr.Host
-	for _, host := range hosts {
-		switch name {
-		case "id":
-			if StrSliceContains(values, host.ID) {
-				filteredHosts = append(filteredHosts, host)
-			}
-			if RegexMatches(regexes, host.ID) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "hostname":
-			if StrSliceContains(values, host.Info.Hostname) {
-				filteredHosts = append(filteredHosts, host)
-			}
-			if RegexMatches(regexes, host.Info.Hostname) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "os_family":
-			if StrSliceContains(values, host.Info.OSFamily) {
-				filteredHosts = append(filteredHosts, host)
-			}
-			if RegexMatches(regexes, host.Info.OSFamily) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "arch":
-			if StrSliceContains(values, host.Info.Arch) {
-				filteredHosts = append(filteredHosts, host)
-			}
-			if RegexMatches(regexes, host.Info.Arch) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "os_info":
-			if StrSliceContains(values, host.Info.OSInfo) {
-				filteredHosts = append(filteredHosts, host)
-			}
-			if RegexMatches(regexes, host.Info.OSInfo) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "message":
-			if StrSliceContains(values, host.Message) {
-				filteredHosts = append(filteredHosts, host)
-			}
-			if RegexMatches(regexes, host.Message) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "role_status":
-			if StrSliceContains(values, host.RoleStatus) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "responding":
-			if StrSliceContains(values, fmt.Sprintf("%v", host.Info.Responding)) {
-				filteredHosts = append(filteredHosts, host)
-			}
-		case "roles":
-			for _, role := range host.Roles {
-				if StrSliceContains(values, role) {
-					filteredHosts = append(filteredHosts, host)
-					break
-				}
-			}
-		default:
-			return nil, fmt.Errorf("unknown filter: %s", name)
+// resmgrHostToHostsValue converts the host to the same attributes as the pf9_host data source
+func resmgrHostToHostsValue(ctx context.Context, host resmgr.Host) (datasource_hosts.HostsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	extensions, err := host.ReadExtensions()
+	if err != nil {
+		diags.AddError("Failed to read extensions", fmt.Sprintf("Failed to read extensions of the host %v: %v", host.ID, err))
+		return datasource_hosts.HostsValue{}, diags
+	}
+	facts, err := readHostFacts(host)
+	if err != nil {
+		diags.AddError("Failed to read host facts", fmt.Sprintf("Failed to read facts of the host %v: %v", host.ID, err))
+		return datasource_hosts.HostsValue{}, diags
+	}
+	ifaces := []datasource_hosts.InterfacesValue{}
+	for _, ifaceAttributes := range facts.interfacesAttributes(extensions.Interfaces.Data.InterfaceIP) {
+		iface, convertDiags := datasource_hosts.NewInterfacesValue(datasource_hosts.InterfacesValue{}.AttributeTypes(ctx), ifaceAttributes)
+		diags.Append(convertDiags...)
+		if diags.HasError() {
+			return datasource_hosts.HostsValue{}, diags
 		}
+		ifaces = append(ifaces, iface)
 	}
-	return filteredHosts, nil
+	ifacesValue, convertDiags := types.ListValueFrom(ctx, datasource_hosts.InterfacesValue{}.Type(ctx), ifaces)
+	diags.Append(convertDiags...)
+	volumeGroups := []datasource_hosts.VolumeGroupsValue{}
+	for _, volumeGroupAttributes := range facts.volumeGroupsAttributes() {
+		volumeGroup, convertDiags := datasource_hosts.NewVolumeGroupsValue(datasource_hosts.VolumeGroupsValue{}.AttributeTypes(ctx), volumeGroupAttributes)
+		diags.Append(convertDiags...)
+		if diags.HasError() {
+			return datasource_hosts.HostsValue{}, diags
+		}
+		volumeGroups = append(volumeGroups, volumeGroup)
+	}
+	volumeGroupsValue, convertDiags := types.ListValueFrom(ctx, datasource_hosts.VolumeGroupsValue{}.Type(ctx), volumeGroups)
+	diags.Append(convertDiags...)
+	blockDevices := []datasource_hosts.BlockDevicesValue{}
+	for _, blockDeviceAttributes := range facts.blockDevicesAttributes() {
+		blockDevice, convertDiags := datasource_hosts.NewBlockDevicesValue(datasource_hosts.BlockDevicesValue{}.AttributeTypes(ctx), blockDeviceAttributes)
+		diags.Append(convertDiags...)
+		if diags.HasError() {
+			return datasource_hosts.HostsValue{}, diags
+		}
+		blockDevices = append(blockDevices, blockDevice)
+	}
+	blockDevicesValue, convertDiags := types.ListValueFrom(ctx, datasource_hosts.BlockDevicesValue{}.Type(ctx), blockDevices)
+	diags.Append(convertDiags...)
+	cpuValue, convertDiags := objectValueOrNull(datasource_hosts.CpuValue{}.AttributeTypes(ctx), facts.cpuAttributes())
+	diags.Append(convertDiags...)
+	memoryValue, convertDiags := objectValueOrNull(datasource_hosts.MemoryValue{}.AttributeTypes(ctx), facts.memoryAttributes())
+	diags.Append(convertDiags...)
+	hypervisorValue, convertDiags := objectValueOrNull(datasource_hosts.HypervisorValue{}.AttributeTypes(ctx), facts.hypervisorAttributes())
+	diags.Append(convertDiags...)
+	rolesValue, convertDiags := types.ListValueFrom(ctx, types.StringType, host.Roles)
+	diags.Append(convertDiags...)
+	if diags.HasError() {
+		return datasource_hosts.HostsValue{}, diags
+	}
+	hostsValue, convertDiags := datasource_hosts.NewHostsValue(datasource_hosts.HostsValue{}.AttributeTypes(ctx),
+		map[string]attr.Value{
+			"id":                 types.StringValue(host.ID),
+			"hostname":           types.StringValue(host.Info.Hostname),
+			"arch":               types.StringValue(host.Info.Arch),
+			"os_family":          types.StringValue(host.Info.OSFamily),
+			"os_info":            types.StringValue(host.Info.OSInfo),
+			"responding":         types.BoolValue(host.Info.Responding),
+			"last_response_time": getHostLastResponseTime(host.Info.LastResponseTime),
+			"roles":              rolesValue,
+			"role_status":        types.StringValue(host.RoleStatus),
+			"message":            types.StringValue(host.Message),
+			"interfaces":         ifacesValue,
+			"cpu":                cpuValue,
+			"memory":             memoryValue,
+			"volume_groups":      volumeGroupsValue,
+			"block_devices":      blockDevicesValue,
+			"hypervisor":         hypervisorValue,
+			"gpu_present":        types.BoolPointerValue(facts.GPUPresent),
+			"time_skew_seconds":  types.Float64PointerValue(facts.TimeSkewSeconds),
+		})
+	diags.Append(convertDiags...)
+	return hostsValue, diags
+}
+
+var hostFilterAttributes = filterAttributes[resmgr.Host]{
+	attributes: map[string]filterAttribute[resmgr.Host]{
+		"id":          func(host resmgr.Host) []string { return strAttr(host.ID) },
+		"hostname":    func(host resmgr.Host) []string { return strAttr(host.Info.Hostname) },
+		"os_family":   func(host resmgr.Host) []string { return strAttr(host.Info.OSFamily) },
+		"arch":        func(host resmgr.Host) []string { return strAttr(host.Info.Arch) },
+		"os_info":     func(host resmgr.Host) []string { return strAttr(host.Info.OSInfo) },
+		"message":     func(host resmgr.Host) []string { return strAttr(host.Message) },
+		"role_status": func(host resmgr.Host) []string { return strAttr(host.RoleStatus) },
+		"responding":  func(host resmgr.Host) []string { return strAttr(fmt.Sprintf("%v", host.Info.Responding)) },
+		"roles":       func(host resmgr.Host) []string { return host.Roles },
+	},
 }
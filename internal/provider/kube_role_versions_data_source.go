@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/platform9/pf9-sdk-go/pf9/pmk"
+	"github.com/platform9/terraform-provider-pf9/internal/provider/datasource_kube_role_versions"
+)
+
+var _ datasource.DataSource = (*kubeRoleVersionsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kubeRoleVersionsDataSource)(nil)
+
+func NewKubeRoleVersionsDataSource() datasource.DataSource {
+	return &kubeRoleVersionsDataSource{}
+}
+
+type kubeRoleVersionsDataSource struct {
+	client *pmk.HTTPClient
+}
+
+func (d *kubeRoleVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kube_role_versions"
+}
+
+func (d *kubeRoleVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_kube_role_versions.KubeRoleVersionsDataSourceSchema(ctx)
+}
+
+func (d *kubeRoleVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*dataSourceProviderData).client
+}
+
+func (d *kubeRoleVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_kube_role_versions.KubeRoleVersionsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authInfo, err := d.client.Authenticator().Auth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to authenticate", err.Error())
+		return
+	}
+	projectID := authInfo.ProjectID
+	supportedKubeRoleVersions, err := d.client.Qbert().ListSupportedVersions(projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get supported versions", err.Error())
+		return
+	}
+	roles := []datasource_kube_role_versions.RolesValue{}
+	for _, role := range supportedKubeRoleVersions.Roles {
+		kubeRoleVersion, err := parseKubeRoleVersion(role.RoleVersion)
+		if err != nil {
+			// A role with an unexpected version does not hide the other roles
+			resp.Diagnostics.AddWarning("Skipped kube role version", err.Error())
+			continue
+		}
+		roleValue, diags := datasource_kube_role_versions.NewRolesValue(datasource_kube_role_versions.RolesValue{}.AttributeTypes(ctx),
+			map[string]attr.Value{
+				"role_version":      types.StringValue(role.RoleVersion),
+				"k8s_major_version": types.Int64Value(kubeRoleVersion.K8sMajorVersion),
+				"k8s_minor_version": types.Int64Value(kubeRoleVersion.K8sMinorVersion),
+				"k8s_patch_version": types.Int64Value(kubeRoleVersion.K8sPatchVersion),
+				"pf9_patch_version": getStrOrNullIfEmpty(kubeRoleVersion.Pf9PatchVersion),
+			})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		roles = append(roles, roleValue)
+	}
+	rolesValue, diags := types.ListValueFrom(ctx, datasource_kube_role_versions.RolesValue{}.Type(ctx), roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Roles = rolesValue
+	data.Latest = types.StringNull()
+	if len(supportedKubeRoleVersions.Roles) > 0 {
+		data.Latest = types.StringValue(findLatestKubeRoleVersion(supportedKubeRoleVersions.Roles).RoleVersion)
+	}
+
+	data.CanUpgrade = types.BoolNull()
+	data.CanMinorUpgrade = types.BoolNull()
+	data.CanPatchUpgrade = types.BoolNull()
+	data.MinorUpgradeRoleVersion = types.StringNull()
+	data.PatchUpgradeRoleVersion = types.StringNull()
+	if !data.ClusterId.IsNull() {
+		clusterID := data.ClusterId.ValueString()
+		tflog.Info(ctx, "Reading cluster", map[string]interface{}{"clusterID": clusterID})
+		cluster, err := d.client.Qbert().GetCluster(ctx, projectID, clusterID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get cluster", err.Error())
+			return
+		}
+		data.CanUpgrade = types.BoolValue(cluster.CanUpgrade)
+		data.CanMinorUpgrade = types.BoolValue(cluster.CanMinorUpgrade == 1)
+		data.CanPatchUpgrade = types.BoolValue(cluster.CanPatchUpgrade == 1)
+		if cluster.CanMinorUpgrade == 1 {
+			data.MinorUpgradeRoleVersion = getStrOrNullIfEmpty(cluster.MinorUpgradeRoleVersion)
+		}
+		if cluster.CanPatchUpgrade == 1 {
+			data.PatchUpgradeRoleVersion = getStrOrNullIfEmpty(cluster.PatchUpgradeRoleVersion)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strings"
 
@@ -41,7 +42,7 @@ func (d *kubeconfigDataSource) Configure(ctx context.Context, req datasource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *kubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -55,6 +56,7 @@ func (d *kubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 	clusterID := data.Id.ValueString()
 	authenticationMethod := data.AuthenticationMethod.ValueString()
+	excludeSecrets := data.ExcludeSecrets.ValueBool()
 
 	// Read API call logic
 	authInfo, err := d.client.Authenticator().Auth(ctx)
@@ -77,13 +79,18 @@ func (d *kubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 	kubeconfigStr := string(kubeconfigBlob)
-	if authenticationMethod == "password" {
+	if authenticationMethod == "password" && !excludeSecrets {
 		tflog.Debug(ctx, "Replacing token with base64 encoded username and password")
 		basicAuthToken := getBasicAuthToken()
 		kubeconfigStr = strings.Replace(kubeconfigStr, token, basicAuthToken, 1)
 		token = basicAuthToken
 	}
-	data.Raw = types.StringValue(kubeconfigStr)
+	if excludeSecrets {
+		tflog.Debug(ctx, "Excluding the secrets of the kubeconfig from the state")
+		data.Raw = types.StringNull()
+	} else {
+		data.Raw = types.StringValue(kubeconfigStr)
+	}
 	kubeConfig := KubeConfig{}
 	err = yaml.Unmarshal(kubeconfigBlob, &kubeConfig)
 	if err != nil {
@@ -109,7 +116,7 @@ func (d *kubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			Name:     types.StringValue(kubectx.Name),
 		}
 		if user, ok := usersMap[kubectx.Context.User]; ok {
-			if user.User.Token != "" {
+			if user.User.Token != "" && !excludeSecrets {
 				kc.Token = types.StringValue(user.User.Token)
 			} else {
 				kc.Token = types.StringNull()
@@ -119,7 +126,7 @@ func (d *kubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			} else {
 				kc.ClientCertificate = types.StringNull()
 			}
-			if user.User.ClientKeyData != "" {
+			if user.User.ClientKeyData != "" && !excludeSecrets {
 				kc.ClientKey = types.StringValue(user.User.ClientKeyData)
 			} else {
 				kc.ClientKey = types.StringNull()
@@ -168,6 +175,37 @@ func getBasicAuthToken() string {
 	return base64.StdEncoding.EncodeToString(jsonEncoded)
 }
 
+// getClusterAPIServer returns the API server of the cluster from its kubeconfig, the one
+// of the current context if the kubeconfig has more than one
+func getClusterAPIServer(ctx context.Context, pmkClient *pmk.HTTPClient, clusterID string) (Cluster, error) {
+	authInfo, err := pmkClient.Authenticator().Auth(ctx)
+	if err != nil {
+		return Cluster{}, fmt.Errorf("failed to authenticate: %w", err)
+	}
+	kubeconfigBlob, err := pmkClient.Qbert().GetClusterKubeconfig(authInfo.ProjectID, clusterID, authInfo.Token, qbert.KubeconfigOptions{})
+	if err != nil {
+		return Cluster{}, fmt.Errorf("failed to get cluster kubeconfig: %w", err)
+	}
+	kubeConfig := KubeConfig{}
+	if err := yaml.Unmarshal(kubeconfigBlob, &kubeConfig); err != nil {
+		return Cluster{}, fmt.Errorf("failed to unmarshal kubeconfig: %w", err)
+	}
+	if len(kubeConfig.Clusters) == 0 {
+		return Cluster{}, fmt.Errorf("kubeconfig of the cluster %v has no clusters", clusterID)
+	}
+	for _, kubectx := range kubeConfig.Contexts {
+		if kubectx.Name != kubeConfig.CurrentContext {
+			continue
+		}
+		for _, cluster := range kubeConfig.Clusters {
+			if cluster.Name == kubectx.Context.Cluster {
+				return cluster, nil
+			}
+		}
+	}
+	return kubeConfig.Clusters[0], nil
+}
+
 type Credentials struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -32,7 +32,7 @@ func (d *nodeDataSource) Configure(ctx context.Context, req datasource.Configure
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -44,7 +44,6 @@ func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	nodeID := data.Id.ValueString()
 
 	// Read API call logic
 	authInfo, err := d.client.Authenticator().Auth(ctx)
@@ -59,22 +58,30 @@ func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		resp.Diagnostics.AddError("Failed to list nodes", err.Error())
 		return
 	}
-	for _, node := range nodes {
-		if node.UUID == nodeID {
-			data.Id = types.StringValue(node.UUID)
-			data.Name = types.StringValue(node.Name)
-			data.Status = types.StringValue(node.Status)
-			data.ClusterName = types.StringValue(node.ClusterName)
-			data.ClusterUuid = types.StringValue(node.ClusterUUID)
-			data.NodePoolName = types.StringValue(node.NodePoolName)
-			data.NodePoolUuid = types.StringValue(node.NodePoolUUID)
-			data.IsMaster = types.BoolValue(node.IsMaster != 0)
-			data.ApiResponding = types.BoolValue(node.APIResponding != 0)
-			data.ProjectId = types.StringValue(node.ProjectID)
-			data.ActualKubeRoleVersion = types.StringValue(node.ActualKubeRoleVersion)
-			break
-		}
+	attributeName, value := "id", data.Id.ValueString()
+	switch {
+	case !data.Name.IsNull():
+		attributeName, value = "name", data.Name.ValueString()
+	case !data.PrimaryIp.IsNull():
+		attributeName, value = "primary_ip", data.PrimaryIp.ValueString()
 	}
+	node, diags := lookupOne(nodes, nodeFilterAttributes, "node", attributeName, value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(node.UUID)
+	data.Name = types.StringValue(node.Name)
+	data.PrimaryIp = types.StringValue(node.PrimaryIP)
+	data.Status = types.StringValue(node.Status)
+	data.ClusterName = types.StringValue(node.ClusterName)
+	data.ClusterUuid = types.StringValue(node.ClusterUUID)
+	data.NodePoolName = types.StringValue(node.NodePoolName)
+	data.NodePoolUuid = types.StringValue(node.NodePoolUUID)
+	data.IsMaster = types.BoolValue(node.IsMaster != 0)
+	data.ApiResponding = types.BoolValue(node.APIResponding != 0)
+	data.ProjectId = types.StringValue(node.ProjectID)
+	data.ActualKubeRoleVersion = types.StringValue(node.ActualKubeRoleVersion)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
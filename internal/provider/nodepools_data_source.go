@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -34,11 +35,11 @@ func (d *nodepoolsDataSource) Configure(ctx context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *nodepoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data, state datasource_nodepools.NodepoolsModel
+	var data datasource_nodepools.NodepoolsModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -48,11 +49,29 @@ func (d *nodepoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Read API call logic
-	var values []string
-	name := data.Filter.Name.ValueString()
-	resp.Diagnostics.Append(data.Filter.Values.ElementsAs(ctx, &values, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	filters := []Filter{}
+	if !data.Filters.IsNull() {
+		var filtersValue []datasource_nodepools.FiltersValue
+		diags := data.Filters.ElementsAs(ctx, &filtersValue, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, filterValue := range filtersValue {
+			filter, diags := newFilter(ctx, filterValue.Name, filterValue.Values, filterValue.Regexes, filterValue.Operator)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			filters = append(filters, filter)
+		}
+	} else if !data.Filter.IsNull() {
+		filter, diags := newFilter(ctx, data.Filter.Name, data.Filter.Values, types.SetNull(types.StringType), types.StringNull())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		filters = append(filters, filter)
 	}
 	authInfo, err := d.client.Authenticator().Auth(ctx)
 	if err != nil {
@@ -65,56 +84,88 @@ func (d *nodepoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		resp.Diagnostics.AddError("Failed to get nodepools", err.Error())
 		return
 	}
-	var filteredNodePools []qbert.NodePool
-	for _, nodepool := range nodePools {
-		if name == "name" {
-			if StrSliceContains(values, nodepool.Name) {
-				filteredNodePools = append(filteredNodePools, nodepool)
-			}
-		} else if name == "cloudProviderName" {
-			if StrSliceContains(values, nodepool.CloudProviderName) {
-				filteredNodePools = append(filteredNodePools, nodepool)
-			}
-		} else if name == "cloudProviderUuid" {
-			if StrSliceContains(values, nodepool.CloudProviderUUID) {
-				filteredNodePools = append(filteredNodePools, nodepool)
-			}
-		} else {
-			resp.Diagnostics.AddError("Invalid filter name",
-				"Allowed filter names are: name, id, primary_ip, is_master, api_responding, cluster_name, cluster_uuid, node_pool_name, node_pool_uuid")
-			return
-		}
+	nodes, err := d.client.Qbert().ListNodes(projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list nodes", err.Error())
+		return
 	}
-	tfNodepoolsAttrVals := []attr.Value{}
-	for _, nodepool := range filteredNodePools {
-		tfNodepoolsVal := datasource_nodepools.NodepoolsValue{
-			Id:                types.StringValue(nodepool.UUID),
-			Name:              types.StringValue(nodepool.Name),
-			CloudProviderName: types.StringValue(nodepool.CloudProviderName),
-			CloudProviderUuid: types.StringValue(nodepool.CloudProviderUUID),
-		}
-		tfNodepoolsObjVal, diags := tfNodepoolsVal.ToObjectValue(ctx)
+	filteredNodePools, err := applyFilters(getNodePoolMembers(nodePools, nodes), filters, nodepoolFilterAttributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to filter nodepools", err.Error())
+		return
+	}
+
+	nodepoolsValues := make([]datasource_nodepools.NodepoolsValue, len(filteredNodePools))
+	for i, nodepool := range filteredNodePools {
+		nodeIDsValue, diags := types.ListValueFrom(ctx, types.StringType, nodepool.NodeIDs)
+		resp.Diagnostics.Append(diags...)
+		clusterIDsValue, diags := types.ListValueFrom(ctx, types.StringType, nodepool.ClusterIDs)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		tfNodesAttrVal, diags := datasource_nodepools.NewNodepoolsValue(tfNodepoolsObjVal.AttributeTypes(ctx), tfNodepoolsObjVal.Attributes())
+		nodepoolsValue, diags := datasource_nodepools.NewNodepoolsValue(datasource_nodepools.NodepoolsValue{}.AttributeTypes(ctx),
+			map[string]attr.Value{
+				"id":                  types.StringValue(nodepool.UUID),
+				"name":                types.StringValue(nodepool.Name),
+				"cloud_provider_name": types.StringValue(nodepool.CloudProviderName),
+				"cloud_provider_uuid": types.StringValue(nodepool.CloudProviderUUID),
+				"node_ids":            nodeIDsValue,
+				"cluster_ids":         clusterIDsValue,
+			})
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		tfNodepoolsAttrVals = append(tfNodepoolsAttrVals, tfNodesAttrVal)
+		nodepoolsValues[i] = nodepoolsValue
 	}
 
 	// data value setting
-	state.Id = types.StringValue(data.Id.ValueString())
-	nodepoolsListVal, diags := types.ListValue(datasource_nodepools.NodepoolsValue{}.Type(ctx), tfNodepoolsAttrVals)
+	nodepoolsListVal, diags := types.ListValueFrom(ctx, datasource_nodepools.NodepoolsValue{}.Type(ctx), nodepoolsValues)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Nodepools = nodepoolsListVal
+	data.Nodepools = nodepoolsListVal
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// nodePoolMembers is a nodepool with the nodes in it and the clusters those nodes are attached to
+type nodePoolMembers struct {
+	qbert.NodePool
+	NodeIDs    []string
+	ClusterIDs []string
+}
+
+// getNodePoolMembers finds the members of the nodepools from the NodePoolUUID of the nodes
+func getNodePoolMembers(nodePools []qbert.NodePool, nodes []qbert.Node) []nodePoolMembers {
+	members := make([]nodePoolMembers, len(nodePools))
+	for i, nodePool := range nodePools {
+		members[i] = nodePoolMembers{NodePool: nodePool, NodeIDs: []string{}, ClusterIDs: []string{}}
+		for _, node := range nodes {
+			if node.NodePoolUUID != nodePool.UUID {
+				continue
+			}
+			members[i].NodeIDs = append(members[i].NodeIDs, node.UUID)
+			if node.ClusterUUID != "" && !StrSliceContains(members[i].ClusterIDs, node.ClusterUUID) {
+				members[i].ClusterIDs = append(members[i].ClusterIDs, node.ClusterUUID)
+			}
+		}
+		sort.Strings(members[i].NodeIDs)
+		sort.Strings(members[i].ClusterIDs)
+	}
+	return members
+}
+
+var nodepoolFilterAttributes = filterAttributes[nodePoolMembers]{
+	attributes: map[string]filterAttribute[nodePoolMembers]{
+		"id":                  func(nodepool nodePoolMembers) []string { return strAttr(nodepool.UUID) },
+		"name":                func(nodepool nodePoolMembers) []string { return strAttr(nodepool.Name) },
+		"cloud_provider_name": func(nodepool nodePoolMembers) []string { return strAttr(nodepool.CloudProviderName) },
+		"cloud_provider_uuid": func(nodepool nodePoolMembers) []string { return strAttr(nodepool.CloudProviderUUID) },
+		"node_ids":            func(nodepool nodePoolMembers) []string { return nodepool.NodeIDs },
+		"cluster_ids":         func(nodepool nodePoolMembers) []string { return nodepool.ClusterIDs },
+	},
 }
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/platform9/pf9-sdk-go/pf9/qbert"
+)
+
+func TestGetNodePoolMembers(t *testing.T) {
+	nodePools := []qbert.NodePool{{UUID: "pool-a"}, {UUID: "pool-b"}, {UUID: "pool-empty"}}
+	nodes := []qbert.Node{
+		{UUID: "node-3", NodePoolUUID: "pool-a", ClusterUUID: "cluster-2"},
+		{UUID: "node-1", NodePoolUUID: "pool-a", ClusterUUID: "cluster-1"},
+		{UUID: "node-2", NodePoolUUID: "pool-a", ClusterUUID: "cluster-1"},
+		{UUID: "node-4", NodePoolUUID: "pool-b"},
+		{UUID: "node-5", NodePoolUUID: "pool-other", ClusterUUID: "cluster-3"},
+	}
+	want := []struct {
+		nodeIDs    []string
+		clusterIDs []string
+	}{
+		{nodeIDs: []string{"node-1", "node-2", "node-3"}, clusterIDs: []string{"cluster-1", "cluster-2"}},
+		{nodeIDs: []string{"node-4"}, clusterIDs: []string{}},
+		{nodeIDs: []string{}, clusterIDs: []string{}},
+	}
+	members := getNodePoolMembers(nodePools, nodes)
+	if len(members) != len(want) {
+		t.Fatalf("getNodePoolMembers() returned %d nodepools, want %d", len(members), len(want))
+	}
+	for i, member := range members {
+		if member.UUID != nodePools[i].UUID {
+			t.Errorf("getNodePoolMembers()[%d] = %v, want %v", i, member.UUID, nodePools[i].UUID)
+		}
+		if !reflect.DeepEqual(member.NodeIDs, want[i].nodeIDs) {
+			t.Errorf("node IDs of %v = %v, want %v", member.UUID, member.NodeIDs, want[i].nodeIDs)
+		}
+		if !reflect.DeepEqual(member.ClusterIDs, want[i].clusterIDs) {
+			t.Errorf("cluster IDs of %v = %v, want %v", member.UUID, member.ClusterIDs, want[i].clusterIDs)
+		}
+	}
+}
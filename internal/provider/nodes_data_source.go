@@ -2,8 +2,6 @@ package provider
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -35,7 +33,7 @@ func (d *nodesDataSource) Configure(ctx context.Context, req datasource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	d.client = req.ProviderData.(*pmk.HTTPClient)
+	d.client = req.ProviderData.(*dataSourceProviderData).client
 }
 
 func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -61,6 +59,7 @@ func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	filters := []Filter{}
 	if !data.Filters.IsNull() {
 		var filtersValue []datasource_nodes.FiltersValue
 		diags := data.Filters.ElementsAs(ctx, &filtersValue, false)
@@ -68,33 +67,26 @@ func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		for _, filter := range filtersValue {
-			var values []string
-			name := filter.Name.ValueString()
-			resp.Diagnostics.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+		for _, filterValue := range filtersValue {
+			filter, diags := newFilter(ctx, filterValue.Name, filterValue.Values, filterValue.Regexes, filterValue.Operator)
+			resp.Diagnostics.Append(diags...)
 			if resp.Diagnostics.HasError() {
 				return
 			}
-			var regexes []string
-			resp.Diagnostics.Append(filter.Regexes.ElementsAs(ctx, &regexes, false)...)
-			filteredNodes, err = filterNodes(filteredNodes, name, values, regexes)
-			if err != nil {
-				resp.Diagnostics.AddError("Failed to filter nodes", err.Error())
-				return
-			}
+			filters = append(filters, filter)
 		}
 	} else if !data.Filter.IsNull() {
-		var values []string
-		name := data.Filter.Name.ValueString()
-		resp.Diagnostics.Append(data.Filter.Values.ElementsAs(ctx, &values, false)...)
+		filter, diags := newFilter(ctx, data.Filter.Name, data.Filter.Values, types.SetNull(types.StringType), types.StringNull())
+		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		filteredNodes, err = filterNodes(filteredNodes, name, values, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Failed to filter nodes", err.Error())
-			return
-		}
+		filters = append(filters, filter)
+	}
+	filteredNodes, err = applyFilters(filteredNodes, filters, nodeFilterAttributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to filter nodes", err.Error())
+		return
 	}
 
 	nodeIDs := make([]string, len(filteredNodes))
@@ -142,76 +134,17 @@ func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func filterNodes(nodes []qbert.Node, filterName string, filterValues []string, filterRegexes []string) ([]qbert.Node, error) {
-	var filteredNodes []qbert.Node
-	for _, node := range nodes {
-		if filterName == "name" {
-			if StrSliceContains(filterValues, node.Name) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.Name) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "status" {
-			if StrSliceContains(filterValues, node.Status) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.Status) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "id" {
-			if StrSliceContains(filterValues, node.UUID) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.UUID) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "primary_ip" {
-			if StrSliceContains(filterValues, node.PrimaryIP) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.PrimaryIP) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "is_master" {
-			if StrSliceContains(filterValues, fmt.Sprintf("%v", node.IsMaster != 0)) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "api_responding" {
-			if StrSliceContains(filterValues, fmt.Sprintf("%v", node.APIResponding != 0)) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "cluster_name" {
-			if StrSliceContains(filterValues, node.ClusterName) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.ClusterName) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "cluster_uuid" {
-			if StrSliceContains(filterValues, node.ClusterUUID) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.ClusterUUID) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "node_pool_name" {
-			if StrSliceContains(filterValues, node.NodePoolName) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.NodePoolName) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else if filterName == "node_pool_uuid" {
-			if StrSliceContains(filterValues, node.NodePoolUUID) {
-				filteredNodes = append(filteredNodes, node)
-			}
-			if RegexMatches(filterRegexes, node.NodePoolUUID) {
-				filteredNodes = append(filteredNodes, node)
-			}
-		} else {
-			return nil, errors.New("invalid filter name")
-		}
-	}
-	return filteredNodes, nil
+var nodeFilterAttributes = filterAttributes[qbert.Node]{
+	attributes: map[string]filterAttribute[qbert.Node]{
+		"id":             func(node qbert.Node) []string { return strAttr(node.UUID) },
+		"name":           func(node qbert.Node) []string { return strAttr(node.Name) },
+		"status":         func(node qbert.Node) []string { return strAttr(node.Status) },
+		"primary_ip":     func(node qbert.Node) []string { return strAttr(node.PrimaryIP) },
+		"is_master":      func(node qbert.Node) []string { return boolAttr(node.IsMaster != 0) },
+		"api_responding": func(node qbert.Node) []string { return boolAttr(node.APIResponding != 0) },
+		"cluster_name":   func(node qbert.Node) []string { return strAttr(node.ClusterName) },
+		"cluster_uuid":   func(node qbert.Node) []string { return strAttr(node.ClusterUUID) },
+		"node_pool_name": func(node qbert.Node) []string { return strAttr(node.NodePoolName) },
+		"node_pool_uuid": func(node qbert.Node) []string { return strAttr(node.NodePoolUUID) },
+	},
 }
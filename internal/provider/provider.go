@@ -29,6 +29,20 @@ type pf9Provider struct {
 	Version string
 }
 
+// resourceProviderData is passed to the resources
+type resourceProviderData struct {
+	client           *pmk.HTTPClient
+	qbertAPI         *qbertAPIClient
+	addonsConfig     AddonsClientConfig
+	addonConcurrency int
+}
+
+// dataSourceProviderData is passed to the data sources
+type dataSourceProviderData struct {
+	client   *pmk.HTTPClient
+	qbertAPI *qbertAPIClient
+}
+
 func (p *pf9Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = provider_pf9.Pf9ProviderSchema(ctx)
 }
@@ -72,6 +86,26 @@ func (p *pf9Provider) Configure(ctx context.Context, req provider.ConfigureReque
 	} else {
 		tenant = pf9Model.Tenant.ValueString()
 	}
+
+	addonsConfig := DefaultAddonsClientConfig()
+	if !pf9Model.SunpikeNamespace.IsNull() {
+		addonsConfig.Namespace = pf9Model.SunpikeNamespace.ValueString()
+	}
+	if !pf9Model.AddonNameTemplate.IsNull() {
+		addonsConfig.NameTemplate = pf9Model.AddonNameTemplate.ValueString()
+	}
+	if err := addonsConfig.Validate(); err != nil {
+		resp.Diagnostics.AddError("Invalid addons configuration", err.Error())
+	}
+	addonConcurrency := defaultAddonConcurrency
+	// An unknown value, e.g. from a resource not created yet, gets the default like a null one
+	if !pf9Model.AddonConcurrency.IsNull() && !pf9Model.AddonConcurrency.IsUnknown() {
+		if pf9Model.AddonConcurrency.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("addon_concurrency"), "Invalid addon concurrency",
+				"addon_concurrency must be at least 1")
+		}
+		addonConcurrency = int(pf9Model.AddonConcurrency.ValueInt64())
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -106,10 +140,14 @@ func (p *pf9Provider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 	tflog.Debug(ctx, "Client authenticated AuthInfo: %v", map[string]interface{}{"authInfo": authInfo})
-	resp.ResourceData = client
-	resp.DataSourceData = client
+	qbertAPI := newQbertAPIClient(accountURL, client)
+	resp.ResourceData = &resourceProviderData{client: client, qbertAPI: qbertAPI, addonsConfig: addonsConfig,
+		addonConcurrency: addonConcurrency}
+	resp.DataSourceData = &dataSourceProviderData{client: client, qbertAPI: qbertAPI}
 	tflog.Info(ctx, "Client configured", map[string]interface{}{"accountURL": accountURL, "auth.userID": authInfo.UserID,
-		"auth.projectID": authInfo.ProjectID, "username": username, "tenant": tenant, "region": region})
+		"auth.projectID": authInfo.ProjectID, "username": username, "tenant": tenant, "region": region,
+		"sunpikeNamespace": addonsConfig.Namespace, "addonNameTemplate": addonsConfig.NameTemplate,
+		"addonConcurrency": addonConcurrency})
 }
 
 func (p *pf9Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -127,11 +165,15 @@ func (p *pf9Provider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewKubeconfigDataSource,
 		NewHostDataSource,
 		NewHostsDataSource,
+		NewAddonVersionsDataSource,
+		NewKubeRoleVersionsDataSource,
+		NewClusterNodesDataSource,
 	}
 }
 
 func (p *pf9Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewClusterResource,
+		NewClusterAddonResource,
 	}
 }
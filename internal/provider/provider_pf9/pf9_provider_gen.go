@@ -17,6 +17,16 @@ func Pf9ProviderSchema(ctx context.Context) schema.Schema {
 				Description:         "Account URL associated with platform9 management control plane",
 				MarkdownDescription: "Account URL associated with platform9 management control plane",
 			},
+			"addon_concurrency": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Maximum number of addons of a cluster enabled, patched or disabled at the same time. Defaults to 4.",
+				MarkdownDescription: "Maximum number of addons of a cluster enabled, patched or disabled at the same time. Defaults to 4.",
+			},
+			"addon_name_template": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Template for the names of the ClusterAddon objects created by the provider. The placeholders `{cluster_id}` and `{type}` are replaced with the cluster UUID and the addon type. Defaults to `{cluster_id}-{type}`.",
+				MarkdownDescription: "Template for the names of the ClusterAddon objects created by the provider. The placeholders `{cluster_id}` and `{type}` are replaced with the cluster UUID and the addon type. Defaults to `{cluster_id}-{type}`.",
+			},
 			"password": schema.StringAttribute{
 				Required:            true,
 				Sensitive:           true,
@@ -26,6 +36,11 @@ func Pf9ProviderSchema(ctx context.Context) schema.Schema {
 			"region": schema.StringAttribute{
 				Optional: true,
 			},
+			"sunpike_namespace": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Namespace of the sunpike ClusterAddon objects. Defaults to `default`.",
+				MarkdownDescription: "Namespace of the sunpike ClusterAddon objects. Defaults to `default`.",
+			},
 			"tenant": schema.StringAttribute{
 				Optional: true,
 			},
@@ -39,9 +54,12 @@ func Pf9ProviderSchema(ctx context.Context) schema.Schema {
 }
 
 type Pf9Model struct {
-	AccountUrl types.String `tfsdk:"account_url"`
-	Password   types.String `tfsdk:"password"`
-	Region     types.String `tfsdk:"region"`
-	Tenant     types.String `tfsdk:"tenant"`
-	Username   types.String `tfsdk:"username"`
+	AccountUrl        types.String `tfsdk:"account_url"`
+	AddonConcurrency  types.Int64  `tfsdk:"addon_concurrency"`
+	AddonNameTemplate types.String `tfsdk:"addon_name_template"`
+	Password          types.String `tfsdk:"password"`
+	Region            types.String `tfsdk:"region"`
+	SunpikeNamespace  types.String `tfsdk:"sunpike_namespace"`
+	Tenant            types.String `tfsdk:"tenant"`
+	Username          types.String `tfsdk:"username"`
 }
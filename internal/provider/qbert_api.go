@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/platform9/pf9-sdk-go/pf9/pmk"
+)
+
+const (
+	qbertAPITimeout    = 30 * time.Second
+	qbertAPIMaxRetries = 3
+	qbertAPIRetryWait  = 2 * time.Second
+)
+
+// qbertAPIClient calls the qbert APIs that the SDK has no method for. It authenticates with the
+// keystone token of the SDK client and sends the requests to the account URL of the provider.
+type qbertAPIClient struct {
+	accountURL string
+	client     *pmk.HTTPClient
+	httpClient *http.Client
+	// retryWait is the wait before the first retry, it doubles on every retry
+	retryWait time.Duration
+}
+
+func newQbertAPIClient(accountURL string, client *pmk.HTTPClient) *qbertAPIClient {
+	return &qbertAPIClient{
+		accountURL: strings.TrimSuffix(accountURL, "/"),
+		client:     client,
+		httpClient: &http.Client{
+			// The default transport honors the proxy settings of the environment
+			Transport: http.DefaultTransport.(*http.Transport).Clone(),
+			Timeout:   qbertAPITimeout,
+		},
+		retryWait: qbertAPIRetryWait,
+	}
+}
+
+// getClusterAPI returns the body of the API of the cluster under /qbert/v4/<project>/clusters/<cluster>/
+func (c *qbertAPIClient) getClusterAPI(ctx context.Context, clusterID string, api string) ([]byte, error) {
+	authInfo, err := c.client.Authenticator().Auth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	}
+	url := fmt.Sprintf("%v/qbert/v4/%v/clusters/%v/%v", c.accountURL, authInfo.ProjectID, clusterID, api)
+	return c.get(ctx, url, authInfo.Token)
+}
+
+// get returns the body of a successful GET of the url. Transport errors, rate limited requests and
+// server errors are retried, other errors are returned right away.
+func (c *qbertAPIClient) get(ctx context.Context, url string, token string) ([]byte, error) {
+	wait := c.retryWait
+	for attempt := 0; ; attempt++ {
+		body, retryable, err := c.doGet(ctx, url, token)
+		if err == nil || !retryable || attempt == qbertAPIMaxRetries {
+			return body, err
+		}
+		tflog.Debug(ctx, "Retrying qbert request", map[string]interface{}{"url": url, "attempt": attempt + 1, "error": err.Error()})
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// doGet sends a single GET request, it reports whether a failed request can be retried
+func (c *qbertAPIClient) doGet(ctx context.Context, url string, token string) ([]byte, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Auth-Token", token)
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// The context is done, e.g. the operation timed out
+		if ctx.Err() != nil {
+			return nil, false, err
+		}
+		return nil, true, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to read the response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		return nil, retryable, fmt.Errorf("%v: %v", resp.Status, string(body))
+	}
+	return body, false, nil
+}
+
+// listAddonVersions fetches the versions of the addons supported by the cluster from the addonversions
+// API, keyed by the qbert name of the addon
+func (c *qbertAPIClient) listAddonVersions(ctx context.Context, clusterID string) (map[string][]string, error) {
+	body, err := c.getClusterAPI(ctx, clusterID, "addonversions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addon versions: %w", err)
+	}
+	return decodeAddonVersions(body)
+}
+
+// listAddonOperatorVersions fetches the versions of the addons that the addon operator of the cluster
+// can install from the supportedaddonoperatorversions API, keyed by the type of the addon
+func (c *qbertAPIClient) listAddonOperatorVersions(ctx context.Context, clusterID string) (map[string][]string, error) {
+	body, err := c.getClusterAPI(ctx, clusterID, "supportedaddonoperatorversions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addon operator versions: %w", err)
+	}
+	return decodeAddonVersions(body)
+}
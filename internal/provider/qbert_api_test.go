@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestQbertAPIClientGet(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []int
+		want      string
+		wantErr   string
+		wantCalls int
+	}{
+		{
+			name:      "success",
+			statuses:  []int{http.StatusOK},
+			want:      `{"coredns": "1.11.1"}`,
+			wantCalls: 1,
+		},
+		{
+			name:      "server error is retried",
+			statuses:  []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			want:      `{"coredns": "1.11.1"}`,
+			wantCalls: 3,
+		},
+		{
+			name:      "client error is not retried",
+			statuses:  []int{http.StatusNotFound},
+			wantErr:   "404 Not Found",
+			wantCalls: 1,
+		},
+		{
+			name:      "retries are exhausted",
+			statuses:  []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			wantErr:   "502 Bad Gateway",
+			wantCalls: qbertAPIMaxRetries + 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("X-Auth-Token"); got != "token" {
+					t.Errorf("X-Auth-Token = %q, want token", got)
+				}
+				status := tt.statuses[calls]
+				calls++
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					_, _ = w.Write([]byte(`{"coredns": "1.11.1"}`))
+				}
+			}))
+			defer server.Close()
+			client := newQbertAPIClient(server.URL+"/", nil)
+			client.retryWait = 0
+			got, err := client.get(context.Background(), server.URL+"/addonversions", "token")
+			if calls != tt.wantCalls {
+				t.Errorf("get() sent %v requests, want %v", calls, tt.wantCalls)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("get() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("get() unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("get() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
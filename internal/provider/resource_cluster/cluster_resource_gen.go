@@ -38,6 +38,71 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 			"addons": schema.MapNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"coredns": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"cores_per_replica": schema.Int64Attribute{
+									Optional:            true,
+									Description:         "Number of cores per CoreDNS replica used by the autoscaler, sent as CoresPerReplica",
+									MarkdownDescription: "Number of cores per CoreDNS replica used by the autoscaler, sent as CoresPerReplica",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"dns_domain": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Cluster DNS domain, sent as dnsDomain",
+									MarkdownDescription: "Cluster DNS domain, sent as dnsDomain",
+								},
+								"dns_memory_limit": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Memory limit of the CoreDNS pods, sent as dnsMemoryLimit",
+									MarkdownDescription: "Memory limit of the CoreDNS pods, sent as dnsMemoryLimit",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|M|G|T|P|E|Ki|Mi|Gi|Ti|Pi|Ei)?$`), "Must be a valid quantity, for example 100m or 300Mi"),
+									},
+								},
+								"max_replicas": schema.Int64Attribute{
+									Optional:            true,
+									Description:         "Maximum number of CoreDNS replicas, sent as MaxReplicas",
+									MarkdownDescription: "Maximum number of CoreDNS replicas, sent as MaxReplicas",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"min_replicas": schema.Int64Attribute{
+									Optional:            true,
+									Description:         "Minimum number of CoreDNS replicas, sent as MinReplicas",
+									MarkdownDescription: "Minimum number of CoreDNS replicas, sent as MinReplicas",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"nodes_per_replica": schema.Int64Attribute{
+									Optional:            true,
+									Description:         "Number of nodes per CoreDNS replica used by the autoscaler, sent as NodesPerReplica",
+									MarkdownDescription: "Number of nodes per CoreDNS replica used by the autoscaler, sent as NodesPerReplica",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"poll_period_secs": schema.Int64Attribute{
+									Optional:            true,
+									Description:         "Autoscaler poll period in seconds, sent as PollPeriodSecs",
+									MarkdownDescription: "Autoscaler poll period in seconds, sent as PollPeriodSecs",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+							CustomType: CorednsType{
+								ObjectType: types.ObjectType{
+									AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+								},
+							},
+							Optional:            true,
+							Description:         "Typed params of the coredns addon, mapped to the addon params. Only valid for the coredns addon",
+							MarkdownDescription: "Typed params of the coredns addon, mapped to the addon params. Only valid for the coredns addon",
+						},
 						"enabled": schema.BoolAttribute{
 							Optional:            true,
 							Computed:            true,
@@ -45,12 +110,99 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "Indicates whether the addon is enabled (true) or disabled (false)",
 							Default:             booldefault.StaticBool(true),
 						},
+						"ignore_params": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "Names of the params that are managed outside of Terraform. They are neither compared nor patched, and are not recorded in params",
+							MarkdownDescription: "Names of the params that are managed outside of Terraform. They are neither compared nor patched, and are not recorded in params",
+						},
+						"metallb": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"ip_range": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Comma separated list of IP ranges (start-end) or CIDRs to allocate load balancer IPs from, sent as MetallbIpRange",
+									MarkdownDescription: "Comma separated list of IP ranges (start-end) or CIDRs to allocate load balancer IPs from, sent as MetallbIpRange",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-fA-F.:/-]+(,[0-9a-fA-F.:/-]+)*$`), "Must be a comma separated list of IP ranges (start-end) or CIDRs"),
+									},
+								},
+							},
+							CustomType: MetallbType{
+								ObjectType: types.ObjectType{
+									AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+								},
+							},
+							Optional:            true,
+							Description:         "Typed params of the metallb addon, mapped to the addon params. Only valid for the metallb addon",
+							MarkdownDescription: "Typed params of the metallb addon, mapped to the addon params. Only valid for the metallb addon",
+						},
+						"metrics_server": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"cpu_limit": schema.StringAttribute{
+									Optional:            true,
+									Description:         "CPU limit of the metrics-server pod, sent as metricsCpuLimit",
+									MarkdownDescription: "CPU limit of the metrics-server pod, sent as metricsCpuLimit",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|M|G|T|P|E|Ki|Mi|Gi|Ti|Pi|Ei)?$`), "Must be a valid quantity, for example 100m or 300Mi"),
+									},
+								},
+								"memory_limit": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Memory limit of the metrics-server pod, sent as metricsMemoryLimit",
+									MarkdownDescription: "Memory limit of the metrics-server pod, sent as metricsMemoryLimit",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|M|G|T|P|E|Ki|Mi|Gi|Ti|Pi|Ei)?$`), "Must be a valid quantity, for example 100m or 300Mi"),
+									},
+								},
+							},
+							CustomType: MetricsServerType{
+								ObjectType: types.ObjectType{
+									AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+								},
+							},
+							Optional:            true,
+							Description:         "Typed params of the metrics-server addon, mapped to the addon params. Only valid for the metrics-server addon",
+							MarkdownDescription: "Typed params of the metrics-server addon, mapped to the addon params. Only valid for the metrics-server addon",
+						},
+						"monitoring": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"pvc_size": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Size of the Prometheus volume, sent as pvcSize",
+									MarkdownDescription: "Size of the Prometheus volume, sent as pvcSize",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|M|G|T|P|E|Ki|Mi|Gi|Ti|Pi|Ei)?$`), "Must be a valid quantity, for example 100m or 300Mi"),
+									},
+								},
+								"retention_time": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Retention time of the metrics, sent as retentionTime",
+									MarkdownDescription: "Retention time of the metrics, sent as retentionTime",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(ms|s|m|h|d|w|y))+$`), "Must be a valid duration, for example 7d or 12h"),
+									},
+								},
+								"storage_class_name": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Storage class of the Prometheus volume, sent as storageClassName",
+									MarkdownDescription: "Storage class of the Prometheus volume, sent as storageClassName",
+								},
+							},
+							CustomType: MonitoringType{
+								ObjectType: types.ObjectType{
+									AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+								},
+							},
+							Optional:            true,
+							Description:         "Typed params of the monitoring addon, mapped to the addon params. Only valid for the monitoring addon",
+							MarkdownDescription: "Typed params of the monitoring addon, mapped to the addon params. Only valid for the monitoring addon",
+						},
 						"params": schema.MapAttribute{
 							ElementType:         types.StringType,
 							Optional:            true,
 							Computed:            true,
-							Description:         "A map of configuration parameters specific to the addon",
-							MarkdownDescription: "A map of configuration parameters specific to the addon",
+							Description:         "A map of configuration parameters specific to the addon. Use it for the params that have no typed attribute",
+							MarkdownDescription: "A map of configuration parameters specific to the addon. Use it for the params that have no typed attribute",
 							PlanModifiers: []planmodifier.Map{
 								mapplanmodifier.UseStateForUnknown(),
 							},
@@ -60,6 +212,19 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 							Description:         "Represents the current installation status of the addon, such as Installing or Installed",
 							MarkdownDescription: "Represents the current installation status of the addon, such as Installing or Installed",
 						},
+						"sensitive_params": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Sensitive:           true,
+							Description:         "A map of configuration parameters holding secrets, such as registry credentials. Merged into the params at apply time and redacted from the plan output and the logs",
+							MarkdownDescription: "A map of configuration parameters holding secrets, such as registry credentials. Merged into the params at apply time and redacted from the plan output and the logs",
+						},
+						"sensitive_params_hash": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "Salted HMAC-SHA256 hashes of the applied sensitive params, used to detect changes made outside of Terraform",
+							MarkdownDescription: "Salted HMAC-SHA256 hashes of the applied sensitive params, used to detect changes made outside of Terraform",
+						},
 						"version": schema.StringAttribute{
 							Optional:            true,
 							Computed:            true,
@@ -69,6 +234,14 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
+						"version_constraint": schema.StringAttribute{
+							Optional:            true,
+							Description:         "Version constraint of the addon, such as `>= 0.6, < 0.8`, or `latest`. Resolved to the highest supported version that satisfies it, which is recorded in version. Conflicts with version",
+							MarkdownDescription: "Version constraint of the addon, such as `>= 0.6, < 0.8`, or `latest`. Resolved to the highest supported version that satisfies it, which is recorded in version. Conflicts with version",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("version")),
+							},
+						},
 					},
 					CustomType: AddonsType{
 						ObjectType: types.ObjectType{
@@ -93,6 +266,14 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Default: booldefault.StaticBool(false),
 			},
+			"api_endpoint": schema.StringAttribute{
+				Computed:            true,
+				Description:         "URL of the Kubernetes API server, built from external_dns_name, or master_ip when it is not set, and k8s_api_port. Use it to configure the kubernetes and helm providers",
+				MarkdownDescription: "URL of the Kubernetes API server, built from external_dns_name, or master_ip when it is not set, and k8s_api_port. Use it to configure the kubernetes and helm providers",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"batch_upgrade_percent": schema.Int64Attribute{
 				Optional:            true,
 				Description:         "Percentage of nodes to upgrade at a time during a batch upgrade. If this attribute is omitted then nodes will be sequentially upgraded, one after the other.",
@@ -283,6 +464,14 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Base64 encoded CA certificate of the Kubernetes API server, read from the kubeconfig of the cluster. Use base64decode() to configure the kubernetes and helm providers",
+				MarkdownDescription: "Base64 encoded CA certificate of the Kubernetes API server, read from the kubeconfig of the cluster. Use base64decode() to configure the kubernetes and helm providers",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"container_runtime": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -911,6 +1100,34 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"timeouts": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Maximum time to wait for each addon to be installed when the cluster is created, including the time the cluster takes to come up. Defaults to 20m, and 30m for monitoring and kubevirt",
+						MarkdownDescription: "Maximum time to wait for each addon to be installed when the cluster is created, including the time the cluster takes to come up. Defaults to 20m, and 30m for monitoring and kubevirt",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`), "Must be a valid duration, for example 45m or 1h30m"),
+						},
+					},
+					"update": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Maximum time to wait for each addon to be installed or removed when the cluster is updated. Defaults to 20m for an install, 30m for monitoring and kubevirt, and 10m for a removal",
+						MarkdownDescription: "Maximum time to wait for each addon to be installed or removed when the cluster is updated. Defaults to 20m for an install, 30m for monitoring and kubevirt, and 10m for a removal",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`), "Must be a valid duration, for example 45m or 1h30m"),
+						},
+					},
+				},
+				CustomType: TimeoutsType{
+					ObjectType: types.ObjectType{
+						AttrTypes: TimeoutsValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional:            true,
+				Description:         "Timeouts of the waits for the addons of the cluster",
+				MarkdownDescription: "Timeouts of the waits for the addons of the cluster",
+			},
 			"topology_manager_policy": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -958,6 +1175,7 @@ func ClusterResourceSchema(ctx context.Context) schema.Schema {
 type ClusterModel struct {
 	Addons                     types.Map           `tfsdk:"addons"`
 	AllowWorkloadsOnMaster     types.Bool          `tfsdk:"allow_workloads_on_master"`
+	ApiEndpoint                types.String        `tfsdk:"api_endpoint"`
 	BatchUpgradePercent        types.Int64         `tfsdk:"batch_upgrade_percent"`
 	CalicoIpIpMode             types.String        `tfsdk:"calico_ip_ip_mode"`
 	CalicoIpv4                 types.String        `tfsdk:"calico_ipv4"`
@@ -973,6 +1191,7 @@ type ClusterModel struct {
 	CalicoV4BlockSize          types.String        `tfsdk:"calico_v4_block_size"`
 	CertExpiryHrs              types.Int64         `tfsdk:"cert_expiry_hrs"`
 	CloudProvider              CloudProviderValue  `tfsdk:"cloud_provider"`
+	ClusterCaCertificate       types.String        `tfsdk:"cluster_ca_certificate"`
 	ContainerRuntime           types.String        `tfsdk:"container_runtime"`
 	ContainersCidr             types.String        `tfsdk:"containers_cidr"`
 	CpuManagerPolicy           types.String        `tfsdk:"cpu_manager_policy"`
@@ -1017,6 +1236,7 @@ type ClusterModel struct {
 	ServicesCidr               types.String        `tfsdk:"services_cidr"`
 	Status                     StatusValue         `tfsdk:"status"`
 	Tags                       types.Map           `tfsdk:"tags"`
+	Timeouts                   TimeoutsValue       `tfsdk:"timeouts"`
 	TopologyManagerPolicy      types.String        `tfsdk:"topology_manager_policy"`
 	UpgradeKubeRoleVersion     types.String        `tfsdk:"upgrade_kube_role_version"`
 	UseHostname                types.Bool          `tfsdk:"use_hostname"`
@@ -1048,6 +1268,24 @@ func (t AddonsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValu
 
 	attributes := in.Attributes()
 
+	corednsAttribute, ok := attributes["coredns"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`coredns is missing from object`)
+
+		return nil, diags
+	}
+
+	corednsVal, ok := corednsAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`coredns expected to be basetypes.ObjectValue, was: %T`, corednsAttribute))
+	}
+
 	enabledAttribute, ok := attributes["enabled"]
 
 	if !ok {
@@ -1066,6 +1304,78 @@ func (t AddonsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValu
 			fmt.Sprintf(`enabled expected to be basetypes.BoolValue, was: %T`, enabledAttribute))
 	}
 
+	ignoreParamsAttribute, ok := attributes["ignore_params"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ignore_params is missing from object`)
+
+		return nil, diags
+	}
+
+	ignoreParamsVal, ok := ignoreParamsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ignore_params expected to be basetypes.SetValue, was: %T`, ignoreParamsAttribute))
+	}
+
+	metallbAttribute, ok := attributes["metallb"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`metallb is missing from object`)
+
+		return nil, diags
+	}
+
+	metallbVal, ok := metallbAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`metallb expected to be basetypes.ObjectValue, was: %T`, metallbAttribute))
+	}
+
+	metricsServerAttribute, ok := attributes["metrics_server"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`metrics_server is missing from object`)
+
+		return nil, diags
+	}
+
+	metricsServerVal, ok := metricsServerAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`metrics_server expected to be basetypes.ObjectValue, was: %T`, metricsServerAttribute))
+	}
+
+	monitoringAttribute, ok := attributes["monitoring"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`monitoring is missing from object`)
+
+		return nil, diags
+	}
+
+	monitoringVal, ok := monitoringAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`monitoring expected to be basetypes.ObjectValue, was: %T`, monitoringAttribute))
+	}
+
 	paramsAttribute, ok := attributes["params"]
 
 	if !ok {
@@ -1102,6 +1412,42 @@ func (t AddonsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValu
 			fmt.Sprintf(`phase expected to be basetypes.StringValue, was: %T`, phaseAttribute))
 	}
 
+	sensitiveParamsAttribute, ok := attributes["sensitive_params"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sensitive_params is missing from object`)
+
+		return nil, diags
+	}
+
+	sensitiveParamsVal, ok := sensitiveParamsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sensitive_params expected to be basetypes.MapValue, was: %T`, sensitiveParamsAttribute))
+	}
+
+	sensitiveParamsHashAttribute, ok := attributes["sensitive_params_hash"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sensitive_params_hash is missing from object`)
+
+		return nil, diags
+	}
+
+	sensitiveParamsHashVal, ok := sensitiveParamsHashAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sensitive_params_hash expected to be basetypes.MapValue, was: %T`, sensitiveParamsHashAttribute))
+	}
+
 	versionAttribute, ok := attributes["version"]
 
 	if !ok {
@@ -1120,16 +1466,42 @@ func (t AddonsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValu
 			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
 	}
 
+	versionConstraintAttribute, ok := attributes["version_constraint"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`version_constraint is missing from object`)
+
+		return nil, diags
+	}
+
+	versionConstraintVal, ok := versionConstraintAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`version_constraint expected to be basetypes.StringValue, was: %T`, versionConstraintAttribute))
+	}
+
 	if diags.HasError() {
 		return nil, diags
 	}
 
 	return AddonsValue{
-		Enabled: enabledVal,
-		Params:  paramsVal,
-		Phase:   phaseVal,
-		Version: versionVal,
-		state:   attr.ValueStateKnown,
+		Coredns:             corednsVal,
+		Enabled:             enabledVal,
+		IgnoreParams:        ignoreParamsVal,
+		Metallb:             metallbVal,
+		MetricsServer:       metricsServerVal,
+		Monitoring:          monitoringVal,
+		Params:              paramsVal,
+		Phase:               phaseVal,
+		SensitiveParams:     sensitiveParamsVal,
+		SensitiveParamsHash: sensitiveParamsHashVal,
+		Version:             versionVal,
+		VersionConstraint:   versionConstraintVal,
+		state:               attr.ValueStateKnown,
 	}, diags
 }
 
@@ -1196,6 +1568,24 @@ func NewAddonsValue(attributeTypes map[string]attr.Type, attributes map[string]a
 		return NewAddonsValueUnknown(), diags
 	}
 
+	corednsAttribute, ok := attributes["coredns"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`coredns is missing from object`)
+
+		return NewAddonsValueUnknown(), diags
+	}
+
+	corednsVal, ok := corednsAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`coredns expected to be basetypes.ObjectValue, was: %T`, corednsAttribute))
+	}
+
 	enabledAttribute, ok := attributes["enabled"]
 
 	if !ok {
@@ -1214,101 +1604,235 @@ func NewAddonsValue(attributeTypes map[string]attr.Type, attributes map[string]a
 			fmt.Sprintf(`enabled expected to be basetypes.BoolValue, was: %T`, enabledAttribute))
 	}
 
-	paramsAttribute, ok := attributes["params"]
+	ignoreParamsAttribute, ok := attributes["ignore_params"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`params is missing from object`)
+			`ignore_params is missing from object`)
 
 		return NewAddonsValueUnknown(), diags
 	}
 
-	paramsVal, ok := paramsAttribute.(basetypes.MapValue)
+	ignoreParamsVal, ok := ignoreParamsAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`params expected to be basetypes.MapValue, was: %T`, paramsAttribute))
+			fmt.Sprintf(`ignore_params expected to be basetypes.SetValue, was: %T`, ignoreParamsAttribute))
 	}
 
-	phaseAttribute, ok := attributes["phase"]
+	metallbAttribute, ok := attributes["metallb"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`phase is missing from object`)
+			`metallb is missing from object`)
 
 		return NewAddonsValueUnknown(), diags
 	}
 
-	phaseVal, ok := phaseAttribute.(basetypes.StringValue)
+	metallbVal, ok := metallbAttribute.(basetypes.ObjectValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`phase expected to be basetypes.StringValue, was: %T`, phaseAttribute))
+			fmt.Sprintf(`metallb expected to be basetypes.ObjectValue, was: %T`, metallbAttribute))
 	}
 
-	versionAttribute, ok := attributes["version"]
+	metricsServerAttribute, ok := attributes["metrics_server"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`version is missing from object`)
+			`metrics_server is missing from object`)
 
 		return NewAddonsValueUnknown(), diags
 	}
 
-	versionVal, ok := versionAttribute.(basetypes.StringValue)
+	metricsServerVal, ok := metricsServerAttribute.(basetypes.ObjectValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
+			fmt.Sprintf(`metrics_server expected to be basetypes.ObjectValue, was: %T`, metricsServerAttribute))
 	}
 
-	if diags.HasError() {
+	monitoringAttribute, ok := attributes["monitoring"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`monitoring is missing from object`)
+
 		return NewAddonsValueUnknown(), diags
 	}
 
-	return AddonsValue{
-		Enabled: enabledVal,
-		Params:  paramsVal,
-		Phase:   phaseVal,
-		Version: versionVal,
-		state:   attr.ValueStateKnown,
-	}, diags
-}
+	monitoringVal, ok := monitoringAttribute.(basetypes.ObjectValue)
 
-func NewAddonsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) AddonsValue {
-	object, diags := NewAddonsValue(attributeTypes, attributes)
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`monitoring expected to be basetypes.ObjectValue, was: %T`, monitoringAttribute))
+	}
 
-	if diags.HasError() {
-		// This could potentially be added to the diag package.
-		diagsStrings := make([]string, 0, len(diags))
+	paramsAttribute, ok := attributes["params"]
 
-		for _, diagnostic := range diags {
-			diagsStrings = append(diagsStrings, fmt.Sprintf(
-				"%s | %s | %s",
-				diagnostic.Severity(),
-				diagnostic.Summary(),
-				diagnostic.Detail()))
-		}
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`params is missing from object`)
 
-		panic("NewAddonsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		return NewAddonsValueUnknown(), diags
 	}
 
-	return object
-}
+	paramsVal, ok := paramsAttribute.(basetypes.MapValue)
 
-func (t AddonsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	if in.Type() == nil {
-		return NewAddonsValueNull(), nil
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`params expected to be basetypes.MapValue, was: %T`, paramsAttribute))
 	}
 
-	if !in.Type().Equal(t.TerraformType(ctx)) {
-		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	phaseAttribute, ok := attributes["phase"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`phase is missing from object`)
+
+		return NewAddonsValueUnknown(), diags
+	}
+
+	phaseVal, ok := phaseAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`phase expected to be basetypes.StringValue, was: %T`, phaseAttribute))
+	}
+
+	sensitiveParamsAttribute, ok := attributes["sensitive_params"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sensitive_params is missing from object`)
+
+		return NewAddonsValueUnknown(), diags
+	}
+
+	sensitiveParamsVal, ok := sensitiveParamsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sensitive_params expected to be basetypes.MapValue, was: %T`, sensitiveParamsAttribute))
+	}
+
+	sensitiveParamsHashAttribute, ok := attributes["sensitive_params_hash"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`sensitive_params_hash is missing from object`)
+
+		return NewAddonsValueUnknown(), diags
+	}
+
+	sensitiveParamsHashVal, ok := sensitiveParamsHashAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`sensitive_params_hash expected to be basetypes.MapValue, was: %T`, sensitiveParamsHashAttribute))
+	}
+
+	versionAttribute, ok := attributes["version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`version is missing from object`)
+
+		return NewAddonsValueUnknown(), diags
+	}
+
+	versionVal, ok := versionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
+	}
+
+	versionConstraintAttribute, ok := attributes["version_constraint"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`version_constraint is missing from object`)
+
+		return NewAddonsValueUnknown(), diags
+	}
+
+	versionConstraintVal, ok := versionConstraintAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`version_constraint expected to be basetypes.StringValue, was: %T`, versionConstraintAttribute))
+	}
+
+	if diags.HasError() {
+		return NewAddonsValueUnknown(), diags
+	}
+
+	return AddonsValue{
+		Coredns:             corednsVal,
+		Enabled:             enabledVal,
+		IgnoreParams:        ignoreParamsVal,
+		Metallb:             metallbVal,
+		MetricsServer:       metricsServerVal,
+		Monitoring:          monitoringVal,
+		Params:              paramsVal,
+		Phase:               phaseVal,
+		SensitiveParams:     sensitiveParamsVal,
+		SensitiveParamsHash: sensitiveParamsHashVal,
+		Version:             versionVal,
+		VersionConstraint:   versionConstraintVal,
+		state:               attr.ValueStateKnown,
+	}, diags
+}
+
+func NewAddonsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) AddonsValue {
+	object, diags := NewAddonsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewAddonsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t AddonsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewAddonsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
 	}
 
 	if !in.IsKnown() {
@@ -1349,31 +1873,69 @@ func (t AddonsType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = AddonsValue{}
 
 type AddonsValue struct {
-	Enabled basetypes.BoolValue   `tfsdk:"enabled"`
-	Params  basetypes.MapValue    `tfsdk:"params"`
-	Phase   basetypes.StringValue `tfsdk:"phase"`
-	Version basetypes.StringValue `tfsdk:"version"`
-	state   attr.ValueState
+	Coredns             basetypes.ObjectValue `tfsdk:"coredns"`
+	Enabled             basetypes.BoolValue   `tfsdk:"enabled"`
+	IgnoreParams        basetypes.SetValue    `tfsdk:"ignore_params"`
+	Metallb             basetypes.ObjectValue `tfsdk:"metallb"`
+	MetricsServer       basetypes.ObjectValue `tfsdk:"metrics_server"`
+	Monitoring          basetypes.ObjectValue `tfsdk:"monitoring"`
+	Params              basetypes.MapValue    `tfsdk:"params"`
+	Phase               basetypes.StringValue `tfsdk:"phase"`
+	SensitiveParams     basetypes.MapValue    `tfsdk:"sensitive_params"`
+	SensitiveParamsHash basetypes.MapValue    `tfsdk:"sensitive_params_hash"`
+	Version             basetypes.StringValue `tfsdk:"version"`
+	VersionConstraint   basetypes.StringValue `tfsdk:"version_constraint"`
+	state               attr.ValueState
 }
 
 func (v AddonsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 4)
+	attrTypes := make(map[string]tftypes.Type, 12)
 
 	var val tftypes.Value
 	var err error
 
+	attrTypes["coredns"] = basetypes.ObjectType{
+		AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
 	attrTypes["enabled"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["ignore_params"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["metallb"] = basetypes.ObjectType{
+		AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["metrics_server"] = basetypes.ObjectType{
+		AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["monitoring"] = basetypes.ObjectType{
+		AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
 	attrTypes["params"] = basetypes.MapType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
 	attrTypes["phase"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["sensitive_params"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["sensitive_params_hash"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
 	attrTypes["version"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["version_constraint"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 4)
+		vals := make(map[string]tftypes.Value, 12)
+
+		val, err = v.Coredns.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["coredns"] = val
 
 		val, err = v.Enabled.ToTerraformValue(ctx)
 
@@ -1383,6 +1945,38 @@ func (v AddonsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error
 
 		vals["enabled"] = val
 
+		val, err = v.IgnoreParams.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ignore_params"] = val
+
+		val, err = v.Metallb.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["metallb"] = val
+
+		val, err = v.MetricsServer.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["metrics_server"] = val
+
+		val, err = v.Monitoring.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["monitoring"] = val
+
 		val, err = v.Params.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -1399,6 +1993,22 @@ func (v AddonsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error
 
 		vals["phase"] = val
 
+		val, err = v.SensitiveParams.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["sensitive_params"] = val
+
+		val, err = v.SensitiveParamsHash.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["sensitive_params_hash"] = val
+
 		val, err = v.Version.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -1407,6 +2017,14 @@ func (v AddonsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error
 
 		vals["version"] = val
 
+		val, err = v.VersionConstraint.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["version_constraint"] = val
+
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
@@ -1436,101 +2054,402 @@ func (v AddonsValue) String() string {
 func (v AddonsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	paramsVal, d := types.MapValue(types.StringType, v.Params.Elements())
+	var coredns basetypes.ObjectValue
+
+	if v.Coredns.IsNull() {
+		coredns = types.ObjectNull(
+			CorednsValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Coredns.IsUnknown() {
+		coredns = types.ObjectUnknown(
+			CorednsValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Coredns.IsNull() && !v.Coredns.IsUnknown() {
+		coredns = types.ObjectValueMust(
+			CorednsValue{}.AttributeTypes(ctx),
+			v.Coredns.Attributes(),
+		)
+	}
+
+	ignoreParamsVal, d := types.SetValue(types.StringType, v.IgnoreParams.Elements())
 
 	diags.Append(d...)
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
+			"coredns": basetypes.ObjectType{
+				AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+			},
 			"enabled": basetypes.BoolType{},
-			"params": basetypes.MapType{
+			"ignore_params": basetypes.SetType{
 				ElemType: types.StringType,
 			},
-			"phase":   basetypes.StringType{},
-			"version": basetypes.StringType{},
-		}), diags
-	}
-
-	objVal, diags := types.ObjectValue(
-		map[string]attr.Type{
-			"enabled": basetypes.BoolType{},
+			"metallb": basetypes.ObjectType{
+				AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+			},
+			"metrics_server": basetypes.ObjectType{
+				AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+			},
+			"monitoring": basetypes.ObjectType{
+				AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+			},
 			"params": basetypes.MapType{
 				ElemType: types.StringType,
 			},
-			"phase":   basetypes.StringType{},
-			"version": basetypes.StringType{},
-		},
-		map[string]attr.Value{
-			"enabled": v.Enabled,
-			"params":  paramsVal,
-			"phase":   v.Phase,
-			"version": v.Version,
-		})
-
-	return objVal, diags
-}
+			"phase": basetypes.StringType{},
+			"sensitive_params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"sensitive_params_hash": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"version":            basetypes.StringType{},
+			"version_constraint": basetypes.StringType{},
+		}), diags
+	}
 
-func (v AddonsValue) Equal(o attr.Value) bool {
-	other, ok := o.(AddonsValue)
+	var metallb basetypes.ObjectValue
 
-	if !ok {
-		return false
+	if v.Metallb.IsNull() {
+		metallb = types.ObjectNull(
+			MetallbValue{}.AttributeTypes(ctx),
+		)
 	}
 
-	if v.state != other.state {
-		return false
+	if v.Metallb.IsUnknown() {
+		metallb = types.ObjectUnknown(
+			MetallbValue{}.AttributeTypes(ctx),
+		)
 	}
 
-	if v.state != attr.ValueStateKnown {
-		return true
+	if !v.Metallb.IsNull() && !v.Metallb.IsUnknown() {
+		metallb = types.ObjectValueMust(
+			MetallbValue{}.AttributeTypes(ctx),
+			v.Metallb.Attributes(),
+		)
 	}
 
-	if !v.Enabled.Equal(other.Enabled) {
-		return false
-	}
+	var metricsServer basetypes.ObjectValue
 
-	if !v.Params.Equal(other.Params) {
-		return false
+	if v.MetricsServer.IsNull() {
+		metricsServer = types.ObjectNull(
+			MetricsServerValue{}.AttributeTypes(ctx),
+		)
 	}
 
-	if !v.Phase.Equal(other.Phase) {
-		return false
+	if v.MetricsServer.IsUnknown() {
+		metricsServer = types.ObjectUnknown(
+			MetricsServerValue{}.AttributeTypes(ctx),
+		)
 	}
 
-	if !v.Version.Equal(other.Version) {
-		return false
+	if !v.MetricsServer.IsNull() && !v.MetricsServer.IsUnknown() {
+		metricsServer = types.ObjectValueMust(
+			MetricsServerValue{}.AttributeTypes(ctx),
+			v.MetricsServer.Attributes(),
+		)
 	}
 
-	return true
-}
+	var monitoring basetypes.ObjectValue
 
-func (v AddonsValue) Type(ctx context.Context) attr.Type {
-	return AddonsType{
-		basetypes.ObjectType{
-			AttrTypes: v.AttributeTypes(ctx),
-		},
+	if v.Monitoring.IsNull() {
+		monitoring = types.ObjectNull(
+			MonitoringValue{}.AttributeTypes(ctx),
+		)
 	}
-}
 
-func (v AddonsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
-	return map[string]attr.Type{
-		"enabled": basetypes.BoolType{},
-		"params": basetypes.MapType{
-			ElemType: types.StringType,
-		},
-		"phase":   basetypes.StringType{},
-		"version": basetypes.StringType{},
+	if v.Monitoring.IsUnknown() {
+		monitoring = types.ObjectUnknown(
+			MonitoringValue{}.AttributeTypes(ctx),
+		)
 	}
-}
 
-var _ basetypes.ObjectTypable = CalicoLimitsType{}
+	if !v.Monitoring.IsNull() && !v.Monitoring.IsUnknown() {
+		monitoring = types.ObjectValueMust(
+			MonitoringValue{}.AttributeTypes(ctx),
+			v.Monitoring.Attributes(),
+		)
+	}
 
-type CalicoLimitsType struct {
+	paramsVal, d := types.MapValue(types.StringType, v.Params.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"coredns": basetypes.ObjectType{
+				AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+			},
+			"enabled": basetypes.BoolType{},
+			"ignore_params": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"metallb": basetypes.ObjectType{
+				AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+			},
+			"metrics_server": basetypes.ObjectType{
+				AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+			},
+			"monitoring": basetypes.ObjectType{
+				AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+			},
+			"params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"phase": basetypes.StringType{},
+			"sensitive_params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"sensitive_params_hash": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"version":            basetypes.StringType{},
+			"version_constraint": basetypes.StringType{},
+		}), diags
+	}
+
+	sensitiveParamsVal, d := types.MapValue(types.StringType, v.SensitiveParams.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"coredns": basetypes.ObjectType{
+				AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+			},
+			"enabled": basetypes.BoolType{},
+			"ignore_params": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"metallb": basetypes.ObjectType{
+				AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+			},
+			"metrics_server": basetypes.ObjectType{
+				AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+			},
+			"monitoring": basetypes.ObjectType{
+				AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+			},
+			"params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"phase": basetypes.StringType{},
+			"sensitive_params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"sensitive_params_hash": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"version":            basetypes.StringType{},
+			"version_constraint": basetypes.StringType{},
+		}), diags
+	}
+
+	sensitiveParamsHashVal, d := types.MapValue(types.StringType, v.SensitiveParamsHash.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"coredns": basetypes.ObjectType{
+				AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+			},
+			"enabled": basetypes.BoolType{},
+			"ignore_params": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"metallb": basetypes.ObjectType{
+				AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+			},
+			"metrics_server": basetypes.ObjectType{
+				AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+			},
+			"monitoring": basetypes.ObjectType{
+				AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+			},
+			"params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"phase": basetypes.StringType{},
+			"sensitive_params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"sensitive_params_hash": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"version":            basetypes.StringType{},
+			"version_constraint": basetypes.StringType{},
+		}), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"coredns": basetypes.ObjectType{
+				AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+			},
+			"enabled": basetypes.BoolType{},
+			"ignore_params": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"metallb": basetypes.ObjectType{
+				AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+			},
+			"metrics_server": basetypes.ObjectType{
+				AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+			},
+			"monitoring": basetypes.ObjectType{
+				AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+			},
+			"params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"phase": basetypes.StringType{},
+			"sensitive_params": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"sensitive_params_hash": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"version":            basetypes.StringType{},
+			"version_constraint": basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"coredns":               coredns,
+			"enabled":               v.Enabled,
+			"ignore_params":         ignoreParamsVal,
+			"metallb":               metallb,
+			"metrics_server":        metricsServer,
+			"monitoring":            monitoring,
+			"params":                paramsVal,
+			"phase":                 v.Phase,
+			"sensitive_params":      sensitiveParamsVal,
+			"sensitive_params_hash": sensitiveParamsHashVal,
+			"version":               v.Version,
+			"version_constraint":    v.VersionConstraint,
+		})
+
+	return objVal, diags
+}
+
+func (v AddonsValue) Equal(o attr.Value) bool {
+	other, ok := o.(AddonsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Coredns.Equal(other.Coredns) {
+		return false
+	}
+
+	if !v.Enabled.Equal(other.Enabled) {
+		return false
+	}
+
+	if !v.IgnoreParams.Equal(other.IgnoreParams) {
+		return false
+	}
+
+	if !v.Metallb.Equal(other.Metallb) {
+		return false
+	}
+
+	if !v.MetricsServer.Equal(other.MetricsServer) {
+		return false
+	}
+
+	if !v.Monitoring.Equal(other.Monitoring) {
+		return false
+	}
+
+	if !v.Params.Equal(other.Params) {
+		return false
+	}
+
+	if !v.Phase.Equal(other.Phase) {
+		return false
+	}
+
+	if !v.SensitiveParams.Equal(other.SensitiveParams) {
+		return false
+	}
+
+	if !v.SensitiveParamsHash.Equal(other.SensitiveParamsHash) {
+		return false
+	}
+
+	if !v.Version.Equal(other.Version) {
+		return false
+	}
+
+	if !v.VersionConstraint.Equal(other.VersionConstraint) {
+		return false
+	}
+
+	return true
+}
+
+func (v AddonsValue) Type(ctx context.Context) attr.Type {
+	return AddonsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v AddonsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"coredns": basetypes.ObjectType{
+			AttrTypes: CorednsValue{}.AttributeTypes(ctx),
+		},
+		"enabled": basetypes.BoolType{},
+		"ignore_params": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"metallb": basetypes.ObjectType{
+			AttrTypes: MetallbValue{}.AttributeTypes(ctx),
+		},
+		"metrics_server": basetypes.ObjectType{
+			AttrTypes: MetricsServerValue{}.AttributeTypes(ctx),
+		},
+		"monitoring": basetypes.ObjectType{
+			AttrTypes: MonitoringValue{}.AttributeTypes(ctx),
+		},
+		"params": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"phase": basetypes.StringType{},
+		"sensitive_params": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"sensitive_params_hash": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"version":            basetypes.StringType{},
+		"version_constraint": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = CorednsType{}
+
+type CorednsType struct {
 	basetypes.ObjectType
 }
 
-func (t CalicoLimitsType) Equal(o attr.Type) bool {
-	other, ok := o.(CalicoLimitsType)
+func (t CorednsType) Equal(o attr.Type) bool {
+	other, ok := o.(CorednsType)
 
 	if !ok {
 		return false
@@ -1539,151 +2458,170 @@ func (t CalicoLimitsType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t CalicoLimitsType) String() string {
-	return "CalicoLimitsType"
+func (t CorednsType) String() string {
+	return "CorednsType"
 }
 
-func (t CalicoLimitsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t CorednsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	controllerCpuLimitAttribute, ok := attributes["controller_cpu_limit"]
+	coresPerReplicaAttribute, ok := attributes["cores_per_replica"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`controller_cpu_limit is missing from object`)
+			`cores_per_replica is missing from object`)
 
 		return nil, diags
 	}
 
-	controllerCpuLimitVal, ok := controllerCpuLimitAttribute.(basetypes.StringValue)
+	coresPerReplicaVal, ok := coresPerReplicaAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`controller_cpu_limit expected to be basetypes.StringValue, was: %T`, controllerCpuLimitAttribute))
+			fmt.Sprintf(`cores_per_replica expected to be basetypes.Int64Value, was: %T`, coresPerReplicaAttribute))
 	}
 
-	controllerMemoryLimitAttribute, ok := attributes["controller_memory_limit"]
+	dnsDomainAttribute, ok := attributes["dns_domain"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`controller_memory_limit is missing from object`)
+			`dns_domain is missing from object`)
 
 		return nil, diags
 	}
 
-	controllerMemoryLimitVal, ok := controllerMemoryLimitAttribute.(basetypes.StringValue)
+	dnsDomainVal, ok := dnsDomainAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`controller_memory_limit expected to be basetypes.StringValue, was: %T`, controllerMemoryLimitAttribute))
+			fmt.Sprintf(`dns_domain expected to be basetypes.StringValue, was: %T`, dnsDomainAttribute))
 	}
 
-	nodeCpuLimitAttribute, ok := attributes["node_cpu_limit"]
+	dnsMemoryLimitAttribute, ok := attributes["dns_memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`node_cpu_limit is missing from object`)
+			`dns_memory_limit is missing from object`)
 
 		return nil, diags
 	}
 
-	nodeCpuLimitVal, ok := nodeCpuLimitAttribute.(basetypes.StringValue)
+	dnsMemoryLimitVal, ok := dnsMemoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`node_cpu_limit expected to be basetypes.StringValue, was: %T`, nodeCpuLimitAttribute))
+			fmt.Sprintf(`dns_memory_limit expected to be basetypes.StringValue, was: %T`, dnsMemoryLimitAttribute))
 	}
 
-	nodeMemoryLimitAttribute, ok := attributes["node_memory_limit"]
+	maxReplicasAttribute, ok := attributes["max_replicas"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`node_memory_limit is missing from object`)
+			`max_replicas is missing from object`)
 
 		return nil, diags
 	}
 
-	nodeMemoryLimitVal, ok := nodeMemoryLimitAttribute.(basetypes.StringValue)
+	maxReplicasVal, ok := maxReplicasAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`node_memory_limit expected to be basetypes.StringValue, was: %T`, nodeMemoryLimitAttribute))
+			fmt.Sprintf(`max_replicas expected to be basetypes.Int64Value, was: %T`, maxReplicasAttribute))
 	}
 
-	typhaCpuLimitAttribute, ok := attributes["typha_cpu_limit"]
+	minReplicasAttribute, ok := attributes["min_replicas"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`typha_cpu_limit is missing from object`)
+			`min_replicas is missing from object`)
 
 		return nil, diags
 	}
 
-	typhaCpuLimitVal, ok := typhaCpuLimitAttribute.(basetypes.StringValue)
+	minReplicasVal, ok := minReplicasAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`typha_cpu_limit expected to be basetypes.StringValue, was: %T`, typhaCpuLimitAttribute))
+			fmt.Sprintf(`min_replicas expected to be basetypes.Int64Value, was: %T`, minReplicasAttribute))
 	}
 
-	typhaMemoryLimitAttribute, ok := attributes["typha_memory_limit"]
+	nodesPerReplicaAttribute, ok := attributes["nodes_per_replica"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`typha_memory_limit is missing from object`)
+			`nodes_per_replica is missing from object`)
 
 		return nil, diags
 	}
 
-	typhaMemoryLimitVal, ok := typhaMemoryLimitAttribute.(basetypes.StringValue)
+	nodesPerReplicaVal, ok := nodesPerReplicaAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`typha_memory_limit expected to be basetypes.StringValue, was: %T`, typhaMemoryLimitAttribute))
+			fmt.Sprintf(`nodes_per_replica expected to be basetypes.Int64Value, was: %T`, nodesPerReplicaAttribute))
+	}
+
+	pollPeriodSecsAttribute, ok := attributes["poll_period_secs"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`poll_period_secs is missing from object`)
+
+		return nil, diags
+	}
+
+	pollPeriodSecsVal, ok := pollPeriodSecsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`poll_period_secs expected to be basetypes.Int64Value, was: %T`, pollPeriodSecsAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return CalicoLimitsValue{
-		ControllerCpuLimit:    controllerCpuLimitVal,
-		ControllerMemoryLimit: controllerMemoryLimitVal,
-		NodeCpuLimit:          nodeCpuLimitVal,
-		NodeMemoryLimit:       nodeMemoryLimitVal,
-		TyphaCpuLimit:         typhaCpuLimitVal,
-		TyphaMemoryLimit:      typhaMemoryLimitVal,
-		state:                 attr.ValueStateKnown,
+	return CorednsValue{
+		CoresPerReplica: coresPerReplicaVal,
+		DnsDomain:       dnsDomainVal,
+		DnsMemoryLimit:  dnsMemoryLimitVal,
+		MaxReplicas:     maxReplicasVal,
+		MinReplicas:     minReplicasVal,
+		NodesPerReplica: nodesPerReplicaVal,
+		PollPeriodSecs:  pollPeriodSecsVal,
+		state:           attr.ValueStateKnown,
 	}, diags
 }
 
-func NewCalicoLimitsValueNull() CalicoLimitsValue {
-	return CalicoLimitsValue{
+func NewCorednsValueNull() CorednsValue {
+	return CorednsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewCalicoLimitsValueUnknown() CalicoLimitsValue {
-	return CalicoLimitsValue{
+func NewCorednsValueUnknown() CorednsValue {
+	return CorednsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewCalicoLimitsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CalicoLimitsValue, diag.Diagnostics) {
+func NewCorednsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CorednsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -1694,11 +2632,11 @@ func NewCalicoLimitsValue(attributeTypes map[string]attr.Type, attributes map[st
 
 		if !ok {
 			diags.AddError(
-				"Missing CalicoLimitsValue Attribute Value",
-				"While creating a CalicoLimitsValue value, a missing attribute value was detected. "+
-					"A CalicoLimitsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing CorednsValue Attribute Value",
+				"While creating a CorednsValue value, a missing attribute value was detected. "+
+					"A CorednsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("CalicoLimitsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("CorednsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -1706,12 +2644,12 @@ func NewCalicoLimitsValue(attributeTypes map[string]attr.Type, attributes map[st
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid CalicoLimitsValue Attribute Type",
-				"While creating a CalicoLimitsValue value, an invalid attribute value was detected. "+
-					"A CalicoLimitsValue must use a matching attribute type for the value. "+
+				"Invalid CorednsValue Attribute Type",
+				"While creating a CorednsValue value, an invalid attribute value was detected. "+
+					"A CorednsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("CalicoLimitsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("CalicoLimitsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("CorednsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("CorednsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -1721,144 +2659,163 @@ func NewCalicoLimitsValue(attributeTypes map[string]attr.Type, attributes map[st
 
 		if !ok {
 			diags.AddError(
-				"Extra CalicoLimitsValue Attribute Value",
-				"While creating a CalicoLimitsValue value, an extra attribute value was detected. "+
-					"A CalicoLimitsValue must not contain values beyond the expected attribute types. "+
+				"Extra CorednsValue Attribute Value",
+				"While creating a CorednsValue value, an extra attribute value was detected. "+
+					"A CorednsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra CalicoLimitsValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra CorednsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	controllerCpuLimitAttribute, ok := attributes["controller_cpu_limit"]
+	coresPerReplicaAttribute, ok := attributes["cores_per_replica"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`controller_cpu_limit is missing from object`)
+			`cores_per_replica is missing from object`)
 
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	controllerCpuLimitVal, ok := controllerCpuLimitAttribute.(basetypes.StringValue)
+	coresPerReplicaVal, ok := coresPerReplicaAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`controller_cpu_limit expected to be basetypes.StringValue, was: %T`, controllerCpuLimitAttribute))
+			fmt.Sprintf(`cores_per_replica expected to be basetypes.Int64Value, was: %T`, coresPerReplicaAttribute))
 	}
 
-	controllerMemoryLimitAttribute, ok := attributes["controller_memory_limit"]
+	dnsDomainAttribute, ok := attributes["dns_domain"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`controller_memory_limit is missing from object`)
+			`dns_domain is missing from object`)
 
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	controllerMemoryLimitVal, ok := controllerMemoryLimitAttribute.(basetypes.StringValue)
+	dnsDomainVal, ok := dnsDomainAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`controller_memory_limit expected to be basetypes.StringValue, was: %T`, controllerMemoryLimitAttribute))
+			fmt.Sprintf(`dns_domain expected to be basetypes.StringValue, was: %T`, dnsDomainAttribute))
 	}
 
-	nodeCpuLimitAttribute, ok := attributes["node_cpu_limit"]
+	dnsMemoryLimitAttribute, ok := attributes["dns_memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`node_cpu_limit is missing from object`)
+			`dns_memory_limit is missing from object`)
 
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	nodeCpuLimitVal, ok := nodeCpuLimitAttribute.(basetypes.StringValue)
+	dnsMemoryLimitVal, ok := dnsMemoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`node_cpu_limit expected to be basetypes.StringValue, was: %T`, nodeCpuLimitAttribute))
+			fmt.Sprintf(`dns_memory_limit expected to be basetypes.StringValue, was: %T`, dnsMemoryLimitAttribute))
 	}
 
-	nodeMemoryLimitAttribute, ok := attributes["node_memory_limit"]
+	maxReplicasAttribute, ok := attributes["max_replicas"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`node_memory_limit is missing from object`)
+			`max_replicas is missing from object`)
 
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	nodeMemoryLimitVal, ok := nodeMemoryLimitAttribute.(basetypes.StringValue)
+	maxReplicasVal, ok := maxReplicasAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`node_memory_limit expected to be basetypes.StringValue, was: %T`, nodeMemoryLimitAttribute))
+			fmt.Sprintf(`max_replicas expected to be basetypes.Int64Value, was: %T`, maxReplicasAttribute))
 	}
 
-	typhaCpuLimitAttribute, ok := attributes["typha_cpu_limit"]
+	minReplicasAttribute, ok := attributes["min_replicas"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`typha_cpu_limit is missing from object`)
+			`min_replicas is missing from object`)
 
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	typhaCpuLimitVal, ok := typhaCpuLimitAttribute.(basetypes.StringValue)
+	minReplicasVal, ok := minReplicasAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`typha_cpu_limit expected to be basetypes.StringValue, was: %T`, typhaCpuLimitAttribute))
+			fmt.Sprintf(`min_replicas expected to be basetypes.Int64Value, was: %T`, minReplicasAttribute))
 	}
 
-	typhaMemoryLimitAttribute, ok := attributes["typha_memory_limit"]
+	nodesPerReplicaAttribute, ok := attributes["nodes_per_replica"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`typha_memory_limit is missing from object`)
+			`nodes_per_replica is missing from object`)
 
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	typhaMemoryLimitVal, ok := typhaMemoryLimitAttribute.(basetypes.StringValue)
+	nodesPerReplicaVal, ok := nodesPerReplicaAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`typha_memory_limit expected to be basetypes.StringValue, was: %T`, typhaMemoryLimitAttribute))
+			fmt.Sprintf(`nodes_per_replica expected to be basetypes.Int64Value, was: %T`, nodesPerReplicaAttribute))
+	}
+
+	pollPeriodSecsAttribute, ok := attributes["poll_period_secs"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`poll_period_secs is missing from object`)
+
+		return NewCorednsValueUnknown(), diags
+	}
+
+	pollPeriodSecsVal, ok := pollPeriodSecsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`poll_period_secs expected to be basetypes.Int64Value, was: %T`, pollPeriodSecsAttribute))
 	}
 
 	if diags.HasError() {
-		return NewCalicoLimitsValueUnknown(), diags
+		return NewCorednsValueUnknown(), diags
 	}
 
-	return CalicoLimitsValue{
-		ControllerCpuLimit:    controllerCpuLimitVal,
-		ControllerMemoryLimit: controllerMemoryLimitVal,
-		NodeCpuLimit:          nodeCpuLimitVal,
-		NodeMemoryLimit:       nodeMemoryLimitVal,
-		TyphaCpuLimit:         typhaCpuLimitVal,
-		TyphaMemoryLimit:      typhaMemoryLimitVal,
-		state:                 attr.ValueStateKnown,
+	return CorednsValue{
+		CoresPerReplica: coresPerReplicaVal,
+		DnsDomain:       dnsDomainVal,
+		DnsMemoryLimit:  dnsMemoryLimitVal,
+		MaxReplicas:     maxReplicasVal,
+		MinReplicas:     minReplicasVal,
+		NodesPerReplica: nodesPerReplicaVal,
+		PollPeriodSecs:  pollPeriodSecsVal,
+		state:           attr.ValueStateKnown,
 	}, diags
 }
 
-func NewCalicoLimitsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CalicoLimitsValue {
-	object, diags := NewCalicoLimitsValue(attributeTypes, attributes)
+func NewCorednsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CorednsValue {
+	object, diags := NewCorednsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -1872,15 +2829,15 @@ func NewCalicoLimitsValueMust(attributeTypes map[string]attr.Type, attributes ma
 				diagnostic.Detail()))
 		}
 
-		panic("NewCalicoLimitsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewCorednsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t CalicoLimitsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t CorednsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewCalicoLimitsValueNull(), nil
+		return NewCorednsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -1888,11 +2845,11 @@ func (t CalicoLimitsType) ValueFromTerraform(ctx context.Context, in tftypes.Val
 	}
 
 	if !in.IsKnown() {
-		return NewCalicoLimitsValueUnknown(), nil
+		return NewCorednsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewCalicoLimitsValueNull(), nil
+		return NewCorednsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -1915,91 +2872,101 @@ func (t CalicoLimitsType) ValueFromTerraform(ctx context.Context, in tftypes.Val
 		attributes[k] = a
 	}
 
-	return NewCalicoLimitsValueMust(CalicoLimitsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewCorednsValueMust(CorednsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t CalicoLimitsType) ValueType(ctx context.Context) attr.Value {
-	return CalicoLimitsValue{}
+func (t CorednsType) ValueType(ctx context.Context) attr.Value {
+	return CorednsValue{}
 }
 
-var _ basetypes.ObjectValuable = CalicoLimitsValue{}
+var _ basetypes.ObjectValuable = CorednsValue{}
 
-type CalicoLimitsValue struct {
-	ControllerCpuLimit    basetypes.StringValue `tfsdk:"controller_cpu_limit"`
-	ControllerMemoryLimit basetypes.StringValue `tfsdk:"controller_memory_limit"`
-	NodeCpuLimit          basetypes.StringValue `tfsdk:"node_cpu_limit"`
-	NodeMemoryLimit       basetypes.StringValue `tfsdk:"node_memory_limit"`
-	TyphaCpuLimit         basetypes.StringValue `tfsdk:"typha_cpu_limit"`
-	TyphaMemoryLimit      basetypes.StringValue `tfsdk:"typha_memory_limit"`
-	state                 attr.ValueState
+type CorednsValue struct {
+	CoresPerReplica basetypes.Int64Value  `tfsdk:"cores_per_replica"`
+	DnsDomain       basetypes.StringValue `tfsdk:"dns_domain"`
+	DnsMemoryLimit  basetypes.StringValue `tfsdk:"dns_memory_limit"`
+	MaxReplicas     basetypes.Int64Value  `tfsdk:"max_replicas"`
+	MinReplicas     basetypes.Int64Value  `tfsdk:"min_replicas"`
+	NodesPerReplica basetypes.Int64Value  `tfsdk:"nodes_per_replica"`
+	PollPeriodSecs  basetypes.Int64Value  `tfsdk:"poll_period_secs"`
+	state           attr.ValueState
 }
 
-func (v CalicoLimitsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 6)
+func (v CorednsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 7)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["controller_cpu_limit"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["controller_memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["node_cpu_limit"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["node_memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["typha_cpu_limit"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["typha_memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cores_per_replica"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["dns_domain"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["dns_memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["max_replicas"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["min_replicas"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["nodes_per_replica"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["poll_period_secs"] = basetypes.Int64Type{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 6)
+		vals := make(map[string]tftypes.Value, 7)
 
-		val, err = v.ControllerCpuLimit.ToTerraformValue(ctx)
+		val, err = v.CoresPerReplica.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["controller_cpu_limit"] = val
+		vals["cores_per_replica"] = val
 
-		val, err = v.ControllerMemoryLimit.ToTerraformValue(ctx)
+		val, err = v.DnsDomain.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["controller_memory_limit"] = val
+		vals["dns_domain"] = val
 
-		val, err = v.NodeCpuLimit.ToTerraformValue(ctx)
+		val, err = v.DnsMemoryLimit.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["node_cpu_limit"] = val
+		vals["dns_memory_limit"] = val
 
-		val, err = v.NodeMemoryLimit.ToTerraformValue(ctx)
+		val, err = v.MaxReplicas.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["node_memory_limit"] = val
+		vals["max_replicas"] = val
 
-		val, err = v.TyphaCpuLimit.ToTerraformValue(ctx)
+		val, err = v.MinReplicas.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["typha_cpu_limit"] = val
+		vals["min_replicas"] = val
 
-		val, err = v.TyphaMemoryLimit.ToTerraformValue(ctx)
+		val, err = v.NodesPerReplica.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["typha_memory_limit"] = val
+		vals["nodes_per_replica"] = val
+
+		val, err = v.PollPeriodSecs.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["poll_period_secs"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -2015,44 +2982,46 @@ func (v CalicoLimitsValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 	}
 }
 
-func (v CalicoLimitsValue) IsNull() bool {
+func (v CorednsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v CalicoLimitsValue) IsUnknown() bool {
+func (v CorednsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v CalicoLimitsValue) String() string {
-	return "CalicoLimitsValue"
+func (v CorednsValue) String() string {
+	return "CorednsValue"
 }
 
-func (v CalicoLimitsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v CorednsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"controller_cpu_limit":    basetypes.StringType{},
-			"controller_memory_limit": basetypes.StringType{},
-			"node_cpu_limit":          basetypes.StringType{},
-			"node_memory_limit":       basetypes.StringType{},
-			"typha_cpu_limit":         basetypes.StringType{},
-			"typha_memory_limit":      basetypes.StringType{},
+			"cores_per_replica": basetypes.Int64Type{},
+			"dns_domain":        basetypes.StringType{},
+			"dns_memory_limit":  basetypes.StringType{},
+			"max_replicas":      basetypes.Int64Type{},
+			"min_replicas":      basetypes.Int64Type{},
+			"nodes_per_replica": basetypes.Int64Type{},
+			"poll_period_secs":  basetypes.Int64Type{},
 		},
 		map[string]attr.Value{
-			"controller_cpu_limit":    v.ControllerCpuLimit,
-			"controller_memory_limit": v.ControllerMemoryLimit,
-			"node_cpu_limit":          v.NodeCpuLimit,
-			"node_memory_limit":       v.NodeMemoryLimit,
-			"typha_cpu_limit":         v.TyphaCpuLimit,
-			"typha_memory_limit":      v.TyphaMemoryLimit,
+			"cores_per_replica": v.CoresPerReplica,
+			"dns_domain":        v.DnsDomain,
+			"dns_memory_limit":  v.DnsMemoryLimit,
+			"max_replicas":      v.MaxReplicas,
+			"min_replicas":      v.MinReplicas,
+			"nodes_per_replica": v.NodesPerReplica,
+			"poll_period_secs":  v.PollPeriodSecs,
 		})
 
 	return objVal, diags
 }
 
-func (v CalicoLimitsValue) Equal(o attr.Value) bool {
-	other, ok := o.(CalicoLimitsValue)
+func (v CorednsValue) Equal(o attr.Value) bool {
+	other, ok := o.(CorednsValue)
 
 	if !ok {
 		return false
@@ -2066,60 +3035,65 @@ func (v CalicoLimitsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.ControllerCpuLimit.Equal(other.ControllerCpuLimit) {
+	if !v.CoresPerReplica.Equal(other.CoresPerReplica) {
 		return false
 	}
 
-	if !v.ControllerMemoryLimit.Equal(other.ControllerMemoryLimit) {
+	if !v.DnsDomain.Equal(other.DnsDomain) {
 		return false
 	}
 
-	if !v.NodeCpuLimit.Equal(other.NodeCpuLimit) {
+	if !v.DnsMemoryLimit.Equal(other.DnsMemoryLimit) {
 		return false
 	}
 
-	if !v.NodeMemoryLimit.Equal(other.NodeMemoryLimit) {
+	if !v.MaxReplicas.Equal(other.MaxReplicas) {
 		return false
 	}
 
-	if !v.TyphaCpuLimit.Equal(other.TyphaCpuLimit) {
+	if !v.MinReplicas.Equal(other.MinReplicas) {
 		return false
 	}
 
-	if !v.TyphaMemoryLimit.Equal(other.TyphaMemoryLimit) {
+	if !v.NodesPerReplica.Equal(other.NodesPerReplica) {
+		return false
+	}
+
+	if !v.PollPeriodSecs.Equal(other.PollPeriodSecs) {
 		return false
 	}
 
 	return true
 }
 
-func (v CalicoLimitsValue) Type(ctx context.Context) attr.Type {
-	return CalicoLimitsType{
+func (v CorednsValue) Type(ctx context.Context) attr.Type {
+	return CorednsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v CalicoLimitsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v CorednsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"controller_cpu_limit":    basetypes.StringType{},
-		"controller_memory_limit": basetypes.StringType{},
-		"node_cpu_limit":          basetypes.StringType{},
-		"node_memory_limit":       basetypes.StringType{},
-		"typha_cpu_limit":         basetypes.StringType{},
-		"typha_memory_limit":      basetypes.StringType{},
+		"cores_per_replica": basetypes.Int64Type{},
+		"dns_domain":        basetypes.StringType{},
+		"dns_memory_limit":  basetypes.StringType{},
+		"max_replicas":      basetypes.Int64Type{},
+		"min_replicas":      basetypes.Int64Type{},
+		"nodes_per_replica": basetypes.Int64Type{},
+		"poll_period_secs":  basetypes.Int64Type{},
 	}
 }
 
-var _ basetypes.ObjectTypable = CloudProviderType{}
+var _ basetypes.ObjectTypable = MetallbType{}
 
-type CloudProviderType struct {
+type MetallbType struct {
 	basetypes.ObjectType
 }
 
-func (t CloudProviderType) Equal(o attr.Type) bool {
-	other, ok := o.(CloudProviderType)
+func (t MetallbType) Equal(o attr.Type) bool {
+	other, ok := o.(MetallbType)
 
 	if !ok {
 		return false
@@ -2128,94 +3102,56 @@ func (t CloudProviderType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t CloudProviderType) String() string {
-	return "CloudProviderType"
+func (t MetallbType) String() string {
+	return "MetallbType"
 }
 
-func (t CloudProviderType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t MetallbType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	nameAttribute, ok := attributes["name"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`name is missing from object`)
-
-		return nil, diags
-	}
-
-	nameVal, ok := nameAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
-	}
-
-	typeAttribute, ok := attributes["type"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`type is missing from object`)
-
-		return nil, diags
-	}
-
-	typeVal, ok := typeAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
-	}
-
-	uuidAttribute, ok := attributes["uuid"]
+	ipRangeAttribute, ok := attributes["ip_range"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`uuid is missing from object`)
+			`ip_range is missing from object`)
 
 		return nil, diags
 	}
 
-	uuidVal, ok := uuidAttribute.(basetypes.StringValue)
+	ipRangeVal, ok := ipRangeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`uuid expected to be basetypes.StringValue, was: %T`, uuidAttribute))
+			fmt.Sprintf(`ip_range expected to be basetypes.StringValue, was: %T`, ipRangeAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return CloudProviderValue{
-		Name:              nameVal,
-		CloudProviderType: typeVal,
-		Uuid:              uuidVal,
-		state:             attr.ValueStateKnown,
+	return MetallbValue{
+		IpRange: ipRangeVal,
+		state:   attr.ValueStateKnown,
 	}, diags
 }
 
-func NewCloudProviderValueNull() CloudProviderValue {
-	return CloudProviderValue{
+func NewMetallbValueNull() MetallbValue {
+	return MetallbValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewCloudProviderValueUnknown() CloudProviderValue {
-	return CloudProviderValue{
+func NewMetallbValueUnknown() MetallbValue {
+	return MetallbValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewCloudProviderValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CloudProviderValue, diag.Diagnostics) {
+func NewMetallbValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (MetallbValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -2226,11 +3162,11 @@ func NewCloudProviderValue(attributeTypes map[string]attr.Type, attributes map[s
 
 		if !ok {
 			diags.AddError(
-				"Missing CloudProviderValue Attribute Value",
-				"While creating a CloudProviderValue value, a missing attribute value was detected. "+
-					"A CloudProviderValue must contain values for all attributes, even if null or unknown. "+
+				"Missing MetallbValue Attribute Value",
+				"While creating a MetallbValue value, a missing attribute value was detected. "+
+					"A MetallbValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("CloudProviderValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("MetallbValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -2238,12 +3174,12 @@ func NewCloudProviderValue(attributeTypes map[string]attr.Type, attributes map[s
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid CloudProviderValue Attribute Type",
-				"While creating a CloudProviderValue value, an invalid attribute value was detected. "+
-					"A CloudProviderValue must use a matching attribute type for the value. "+
+				"Invalid MetallbValue Attribute Type",
+				"While creating a MetallbValue value, an invalid attribute value was detected. "+
+					"A MetallbValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("CloudProviderValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("CloudProviderValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("MetallbValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("MetallbValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -2253,87 +3189,49 @@ func NewCloudProviderValue(attributeTypes map[string]attr.Type, attributes map[s
 
 		if !ok {
 			diags.AddError(
-				"Extra CloudProviderValue Attribute Value",
-				"While creating a CloudProviderValue value, an extra attribute value was detected. "+
-					"A CloudProviderValue must not contain values beyond the expected attribute types. "+
+				"Extra MetallbValue Attribute Value",
+				"While creating a MetallbValue value, an extra attribute value was detected. "+
+					"A MetallbValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra CloudProviderValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra MetallbValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewCloudProviderValueUnknown(), diags
-	}
-
-	nameAttribute, ok := attributes["name"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`name is missing from object`)
-
-		return NewCloudProviderValueUnknown(), diags
-	}
-
-	nameVal, ok := nameAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
-	}
-
-	typeAttribute, ok := attributes["type"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`type is missing from object`)
-
-		return NewCloudProviderValueUnknown(), diags
-	}
-
-	typeVal, ok := typeAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+		return NewMetallbValueUnknown(), diags
 	}
 
-	uuidAttribute, ok := attributes["uuid"]
+	ipRangeAttribute, ok := attributes["ip_range"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`uuid is missing from object`)
+			`ip_range is missing from object`)
 
-		return NewCloudProviderValueUnknown(), diags
+		return NewMetallbValueUnknown(), diags
 	}
 
-	uuidVal, ok := uuidAttribute.(basetypes.StringValue)
+	ipRangeVal, ok := ipRangeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`uuid expected to be basetypes.StringValue, was: %T`, uuidAttribute))
+			fmt.Sprintf(`ip_range expected to be basetypes.StringValue, was: %T`, ipRangeAttribute))
 	}
 
 	if diags.HasError() {
-		return NewCloudProviderValueUnknown(), diags
+		return NewMetallbValueUnknown(), diags
 	}
 
-	return CloudProviderValue{
-		Name:              nameVal,
-		CloudProviderType: typeVal,
-		Uuid:              uuidVal,
-		state:             attr.ValueStateKnown,
+	return MetallbValue{
+		IpRange: ipRangeVal,
+		state:   attr.ValueStateKnown,
 	}, diags
 }
 
-func NewCloudProviderValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CloudProviderValue {
-	object, diags := NewCloudProviderValue(attributeTypes, attributes)
+func NewMetallbValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) MetallbValue {
+	object, diags := NewMetallbValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -2347,15 +3245,15 @@ func NewCloudProviderValueMust(attributeTypes map[string]attr.Type, attributes m
 				diagnostic.Detail()))
 		}
 
-		panic("NewCloudProviderValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewMetallbValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t CloudProviderType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t MetallbType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewCloudProviderValueNull(), nil
+		return NewMetallbValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -2363,11 +3261,11 @@ func (t CloudProviderType) ValueFromTerraform(ctx context.Context, in tftypes.Va
 	}
 
 	if !in.IsKnown() {
-		return NewCloudProviderValueUnknown(), nil
+		return NewMetallbValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewCloudProviderValueNull(), nil
+		return NewMetallbValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -2390,61 +3288,41 @@ func (t CloudProviderType) ValueFromTerraform(ctx context.Context, in tftypes.Va
 		attributes[k] = a
 	}
 
-	return NewCloudProviderValueMust(CloudProviderValue{}.AttributeTypes(ctx), attributes), nil
+	return NewMetallbValueMust(MetallbValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t CloudProviderType) ValueType(ctx context.Context) attr.Value {
-	return CloudProviderValue{}
+func (t MetallbType) ValueType(ctx context.Context) attr.Value {
+	return MetallbValue{}
 }
 
-var _ basetypes.ObjectValuable = CloudProviderValue{}
+var _ basetypes.ObjectValuable = MetallbValue{}
 
-type CloudProviderValue struct {
-	Name              basetypes.StringValue `tfsdk:"name"`
-	CloudProviderType basetypes.StringValue `tfsdk:"type"`
-	Uuid              basetypes.StringValue `tfsdk:"uuid"`
-	state             attr.ValueState
+type MetallbValue struct {
+	IpRange basetypes.StringValue `tfsdk:"ip_range"`
+	state   attr.ValueState
 }
 
-func (v CloudProviderValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
+func (v MetallbValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["uuid"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ip_range"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
-
-		val, err = v.Name.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["name"] = val
-
-		val, err = v.CloudProviderType.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["type"] = val
+		vals := make(map[string]tftypes.Value, 1)
 
-		val, err = v.Uuid.ToTerraformValue(ctx)
+		val, err = v.IpRange.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["uuid"] = val
+		vals["ip_range"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -2460,38 +3338,34 @@ func (v CloudProviderValue) ToTerraformValue(ctx context.Context) (tftypes.Value
 	}
 }
 
-func (v CloudProviderValue) IsNull() bool {
+func (v MetallbValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v CloudProviderValue) IsUnknown() bool {
+func (v MetallbValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v CloudProviderValue) String() string {
-	return "CloudProviderValue"
+func (v MetallbValue) String() string {
+	return "MetallbValue"
 }
 
-func (v CloudProviderValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v MetallbValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"name": basetypes.StringType{},
-			"type": basetypes.StringType{},
-			"uuid": basetypes.StringType{},
+			"ip_range": basetypes.StringType{},
 		},
 		map[string]attr.Value{
-			"name": v.Name,
-			"type": v.CloudProviderType,
-			"uuid": v.Uuid,
+			"ip_range": v.IpRange,
 		})
 
 	return objVal, diags
 }
 
-func (v CloudProviderValue) Equal(o attr.Value) bool {
-	other, ok := o.(CloudProviderValue)
+func (v MetallbValue) Equal(o attr.Value) bool {
+	other, ok := o.(MetallbValue)
 
 	if !ok {
 		return false
@@ -2505,45 +3379,35 @@ func (v CloudProviderValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.Name.Equal(other.Name) {
-		return false
-	}
-
-	if !v.CloudProviderType.Equal(other.CloudProviderType) {
-		return false
-	}
-
-	if !v.Uuid.Equal(other.Uuid) {
+	if !v.IpRange.Equal(other.IpRange) {
 		return false
 	}
 
 	return true
 }
 
-func (v CloudProviderValue) Type(ctx context.Context) attr.Type {
-	return CloudProviderType{
+func (v MetallbValue) Type(ctx context.Context) attr.Type {
+	return MetallbType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v CloudProviderValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v MetallbValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"name": basetypes.StringType{},
-		"type": basetypes.StringType{},
-		"uuid": basetypes.StringType{},
+		"ip_range": basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = CustomRegistryType{}
+var _ basetypes.ObjectTypable = MetricsServerType{}
 
-type CustomRegistryType struct {
+type MetricsServerType struct {
 	basetypes.ObjectType
 }
 
-func (t CustomRegistryType) Equal(o attr.Type) bool {
-	other, ok := o.(CustomRegistryType)
+func (t MetricsServerType) Equal(o attr.Type) bool {
+	other, ok := o.(MetricsServerType)
 
 	if !ok {
 		return false
@@ -2552,170 +3416,75 @@ func (t CustomRegistryType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t CustomRegistryType) String() string {
-	return "CustomRegistryType"
+func (t MetricsServerType) String() string {
+	return "MetricsServerType"
 }
 
-func (t CustomRegistryType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t MetricsServerType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	certPathAttribute, ok := attributes["cert_path"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`cert_path is missing from object`)
-
-		return nil, diags
-	}
-
-	certPathVal, ok := certPathAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`cert_path expected to be basetypes.StringValue, was: %T`, certPathAttribute))
-	}
-
-	passwordAttribute, ok := attributes["password"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`password is missing from object`)
-
-		return nil, diags
-	}
-
-	passwordVal, ok := passwordAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`password expected to be basetypes.StringValue, was: %T`, passwordAttribute))
-	}
-
-	repoPathAttribute, ok := attributes["repo_path"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`repo_path is missing from object`)
-
-		return nil, diags
-	}
-
-	repoPathVal, ok := repoPathAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`repo_path expected to be basetypes.StringValue, was: %T`, repoPathAttribute))
-	}
-
-	selfSignedCertsAttribute, ok := attributes["self_signed_certs"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`self_signed_certs is missing from object`)
-
-		return nil, diags
-	}
-
-	selfSignedCertsVal, ok := selfSignedCertsAttribute.(basetypes.BoolValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`self_signed_certs expected to be basetypes.BoolValue, was: %T`, selfSignedCertsAttribute))
-	}
-
-	skipTlsAttribute, ok := attributes["skip_tls"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`skip_tls is missing from object`)
-
-		return nil, diags
-	}
-
-	skipTlsVal, ok := skipTlsAttribute.(basetypes.BoolValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`skip_tls expected to be basetypes.BoolValue, was: %T`, skipTlsAttribute))
-	}
-
-	urlAttribute, ok := attributes["url"]
+	cpuLimitAttribute, ok := attributes["cpu_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`url is missing from object`)
+			`cpu_limit is missing from object`)
 
 		return nil, diags
 	}
 
-	urlVal, ok := urlAttribute.(basetypes.StringValue)
+	cpuLimitVal, ok := cpuLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`url expected to be basetypes.StringValue, was: %T`, urlAttribute))
+			fmt.Sprintf(`cpu_limit expected to be basetypes.StringValue, was: %T`, cpuLimitAttribute))
 	}
 
-	usernameAttribute, ok := attributes["username"]
+	memoryLimitAttribute, ok := attributes["memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`username is missing from object`)
+			`memory_limit is missing from object`)
 
 		return nil, diags
 	}
 
-	usernameVal, ok := usernameAttribute.(basetypes.StringValue)
+	memoryLimitVal, ok := memoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`username expected to be basetypes.StringValue, was: %T`, usernameAttribute))
+			fmt.Sprintf(`memory_limit expected to be basetypes.StringValue, was: %T`, memoryLimitAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return CustomRegistryValue{
-		CertPath:        certPathVal,
-		Password:        passwordVal,
-		RepoPath:        repoPathVal,
-		SelfSignedCerts: selfSignedCertsVal,
-		SkipTls:         skipTlsVal,
-		Url:             urlVal,
-		Username:        usernameVal,
-		state:           attr.ValueStateKnown,
+	return MetricsServerValue{
+		CpuLimit:    cpuLimitVal,
+		MemoryLimit: memoryLimitVal,
+		state:       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewCustomRegistryValueNull() CustomRegistryValue {
-	return CustomRegistryValue{
+func NewMetricsServerValueNull() MetricsServerValue {
+	return MetricsServerValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewCustomRegistryValueUnknown() CustomRegistryValue {
-	return CustomRegistryValue{
+func NewMetricsServerValueUnknown() MetricsServerValue {
+	return MetricsServerValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewCustomRegistryValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CustomRegistryValue, diag.Diagnostics) {
+func NewMetricsServerValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (MetricsServerValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -2726,11 +3495,11 @@ func NewCustomRegistryValue(attributeTypes map[string]attr.Type, attributes map[
 
 		if !ok {
 			diags.AddError(
-				"Missing CustomRegistryValue Attribute Value",
-				"While creating a CustomRegistryValue value, a missing attribute value was detected. "+
-					"A CustomRegistryValue must contain values for all attributes, even if null or unknown. "+
+				"Missing MetricsServerValue Attribute Value",
+				"While creating a MetricsServerValue value, a missing attribute value was detected. "+
+					"A MetricsServerValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("CustomRegistryValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("MetricsServerValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -2738,12 +3507,12 @@ func NewCustomRegistryValue(attributeTypes map[string]attr.Type, attributes map[
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid CustomRegistryValue Attribute Type",
-				"While creating a CustomRegistryValue value, an invalid attribute value was detected. "+
-					"A CustomRegistryValue must use a matching attribute type for the value. "+
+				"Invalid MetricsServerValue Attribute Type",
+				"While creating a MetricsServerValue value, an invalid attribute value was detected. "+
+					"A MetricsServerValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("CustomRegistryValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("CustomRegistryValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("MetricsServerValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("MetricsServerValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -2753,197 +3522,102 @@ func NewCustomRegistryValue(attributeTypes map[string]attr.Type, attributes map[
 
 		if !ok {
 			diags.AddError(
-				"Extra CustomRegistryValue Attribute Value",
-				"While creating a CustomRegistryValue value, an extra attribute value was detected. "+
-					"A CustomRegistryValue must not contain values beyond the expected attribute types. "+
+				"Extra MetricsServerValue Attribute Value",
+				"While creating a MetricsServerValue value, an extra attribute value was detected. "+
+					"A MetricsServerValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra CustomRegistryValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra MetricsServerValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewCustomRegistryValueUnknown(), diags
+		return NewMetricsServerValueUnknown(), diags
 	}
 
-	certPathAttribute, ok := attributes["cert_path"]
+	cpuLimitAttribute, ok := attributes["cpu_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`cert_path is missing from object`)
+			`cpu_limit is missing from object`)
 
-		return NewCustomRegistryValueUnknown(), diags
+		return NewMetricsServerValueUnknown(), diags
 	}
 
-	certPathVal, ok := certPathAttribute.(basetypes.StringValue)
+	cpuLimitVal, ok := cpuLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`cert_path expected to be basetypes.StringValue, was: %T`, certPathAttribute))
+			fmt.Sprintf(`cpu_limit expected to be basetypes.StringValue, was: %T`, cpuLimitAttribute))
 	}
 
-	passwordAttribute, ok := attributes["password"]
+	memoryLimitAttribute, ok := attributes["memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`password is missing from object`)
+			`memory_limit is missing from object`)
 
-		return NewCustomRegistryValueUnknown(), diags
+		return NewMetricsServerValueUnknown(), diags
 	}
 
-	passwordVal, ok := passwordAttribute.(basetypes.StringValue)
+	memoryLimitVal, ok := memoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`password expected to be basetypes.StringValue, was: %T`, passwordAttribute))
+			fmt.Sprintf(`memory_limit expected to be basetypes.StringValue, was: %T`, memoryLimitAttribute))
 	}
 
-	repoPathAttribute, ok := attributes["repo_path"]
+	if diags.HasError() {
+		return NewMetricsServerValueUnknown(), diags
+	}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`repo_path is missing from object`)
+	return MetricsServerValue{
+		CpuLimit:    cpuLimitVal,
+		MemoryLimit: memoryLimitVal,
+		state:       attr.ValueStateKnown,
+	}, diags
+}
 
-		return NewCustomRegistryValueUnknown(), diags
-	}
+func NewMetricsServerValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) MetricsServerValue {
+	object, diags := NewMetricsServerValue(attributeTypes, attributes)
 
-	repoPathVal, ok := repoPathAttribute.(basetypes.StringValue)
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`repo_path expected to be basetypes.StringValue, was: %T`, repoPathAttribute))
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewMetricsServerValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
-	selfSignedCertsAttribute, ok := attributes["self_signed_certs"]
+	return object
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`self_signed_certs is missing from object`)
+func (t MetricsServerType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewMetricsServerValueNull(), nil
+	}
 
-		return NewCustomRegistryValueUnknown(), diags
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
 	}
 
-	selfSignedCertsVal, ok := selfSignedCertsAttribute.(basetypes.BoolValue)
+	if !in.IsKnown() {
+		return NewMetricsServerValueUnknown(), nil
+	}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`self_signed_certs expected to be basetypes.BoolValue, was: %T`, selfSignedCertsAttribute))
-	}
-
-	skipTlsAttribute, ok := attributes["skip_tls"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`skip_tls is missing from object`)
-
-		return NewCustomRegistryValueUnknown(), diags
-	}
-
-	skipTlsVal, ok := skipTlsAttribute.(basetypes.BoolValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`skip_tls expected to be basetypes.BoolValue, was: %T`, skipTlsAttribute))
-	}
-
-	urlAttribute, ok := attributes["url"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`url is missing from object`)
-
-		return NewCustomRegistryValueUnknown(), diags
-	}
-
-	urlVal, ok := urlAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`url expected to be basetypes.StringValue, was: %T`, urlAttribute))
-	}
-
-	usernameAttribute, ok := attributes["username"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`username is missing from object`)
-
-		return NewCustomRegistryValueUnknown(), diags
-	}
-
-	usernameVal, ok := usernameAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`username expected to be basetypes.StringValue, was: %T`, usernameAttribute))
-	}
-
-	if diags.HasError() {
-		return NewCustomRegistryValueUnknown(), diags
-	}
-
-	return CustomRegistryValue{
-		CertPath:        certPathVal,
-		Password:        passwordVal,
-		RepoPath:        repoPathVal,
-		SelfSignedCerts: selfSignedCertsVal,
-		SkipTls:         skipTlsVal,
-		Url:             urlVal,
-		Username:        usernameVal,
-		state:           attr.ValueStateKnown,
-	}, diags
-}
-
-func NewCustomRegistryValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CustomRegistryValue {
-	object, diags := NewCustomRegistryValue(attributeTypes, attributes)
-
-	if diags.HasError() {
-		// This could potentially be added to the diag package.
-		diagsStrings := make([]string, 0, len(diags))
-
-		for _, diagnostic := range diags {
-			diagsStrings = append(diagsStrings, fmt.Sprintf(
-				"%s | %s | %s",
-				diagnostic.Severity(),
-				diagnostic.Summary(),
-				diagnostic.Detail()))
-		}
-
-		panic("NewCustomRegistryValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
-	}
-
-	return object
-}
-
-func (t CustomRegistryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	if in.Type() == nil {
-		return NewCustomRegistryValueNull(), nil
-	}
-
-	if !in.Type().Equal(t.TerraformType(ctx)) {
-		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
-	}
-
-	if !in.IsKnown() {
-		return NewCustomRegistryValueUnknown(), nil
-	}
-
-	if in.IsNull() {
-		return NewCustomRegistryValueNull(), nil
+	if in.IsNull() {
+		return NewMetricsServerValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -2966,101 +3640,51 @@ func (t CustomRegistryType) ValueFromTerraform(ctx context.Context, in tftypes.V
 		attributes[k] = a
 	}
 
-	return NewCustomRegistryValueMust(CustomRegistryValue{}.AttributeTypes(ctx), attributes), nil
+	return NewMetricsServerValueMust(MetricsServerValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t CustomRegistryType) ValueType(ctx context.Context) attr.Value {
-	return CustomRegistryValue{}
+func (t MetricsServerType) ValueType(ctx context.Context) attr.Value {
+	return MetricsServerValue{}
 }
 
-var _ basetypes.ObjectValuable = CustomRegistryValue{}
+var _ basetypes.ObjectValuable = MetricsServerValue{}
 
-type CustomRegistryValue struct {
-	CertPath        basetypes.StringValue `tfsdk:"cert_path"`
-	Password        basetypes.StringValue `tfsdk:"password"`
-	RepoPath        basetypes.StringValue `tfsdk:"repo_path"`
-	SelfSignedCerts basetypes.BoolValue   `tfsdk:"self_signed_certs"`
-	SkipTls         basetypes.BoolValue   `tfsdk:"skip_tls"`
-	Url             basetypes.StringValue `tfsdk:"url"`
-	Username        basetypes.StringValue `tfsdk:"username"`
-	state           attr.ValueState
+type MetricsServerValue struct {
+	CpuLimit    basetypes.StringValue `tfsdk:"cpu_limit"`
+	MemoryLimit basetypes.StringValue `tfsdk:"memory_limit"`
+	state       attr.ValueState
 }
 
-func (v CustomRegistryValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 7)
+func (v MetricsServerValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["cert_path"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["password"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["repo_path"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["self_signed_certs"] = basetypes.BoolType{}.TerraformType(ctx)
-	attrTypes["skip_tls"] = basetypes.BoolType{}.TerraformType(ctx)
-	attrTypes["url"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["username"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cpu_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 7)
-
-		val, err = v.CertPath.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["cert_path"] = val
-
-		val, err = v.Password.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["password"] = val
-
-		val, err = v.RepoPath.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["repo_path"] = val
-
-		val, err = v.SelfSignedCerts.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["self_signed_certs"] = val
-
-		val, err = v.SkipTls.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["skip_tls"] = val
+		vals := make(map[string]tftypes.Value, 2)
 
-		val, err = v.Url.ToTerraformValue(ctx)
+		val, err = v.CpuLimit.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["url"] = val
+		vals["cpu_limit"] = val
 
-		val, err = v.Username.ToTerraformValue(ctx)
+		val, err = v.MemoryLimit.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["username"] = val
+		vals["memory_limit"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -3076,46 +3700,36 @@ func (v CustomRegistryValue) ToTerraformValue(ctx context.Context) (tftypes.Valu
 	}
 }
 
-func (v CustomRegistryValue) IsNull() bool {
+func (v MetricsServerValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v CustomRegistryValue) IsUnknown() bool {
+func (v MetricsServerValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v CustomRegistryValue) String() string {
-	return "CustomRegistryValue"
+func (v MetricsServerValue) String() string {
+	return "MetricsServerValue"
 }
 
-func (v CustomRegistryValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v MetricsServerValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"cert_path":         basetypes.StringType{},
-			"password":          basetypes.StringType{},
-			"repo_path":         basetypes.StringType{},
-			"self_signed_certs": basetypes.BoolType{},
-			"skip_tls":          basetypes.BoolType{},
-			"url":               basetypes.StringType{},
-			"username":          basetypes.StringType{},
+			"cpu_limit":    basetypes.StringType{},
+			"memory_limit": basetypes.StringType{},
 		},
 		map[string]attr.Value{
-			"cert_path":         v.CertPath,
-			"password":          v.Password,
-			"repo_path":         v.RepoPath,
-			"self_signed_certs": v.SelfSignedCerts,
-			"skip_tls":          v.SkipTls,
-			"url":               v.Url,
-			"username":          v.Username,
+			"cpu_limit":    v.CpuLimit,
+			"memory_limit": v.MemoryLimit,
 		})
 
 	return objVal, diags
 }
 
-func (v CustomRegistryValue) Equal(o attr.Value) bool {
-	other, ok := o.(CustomRegistryValue)
+func (v MetricsServerValue) Equal(o attr.Value) bool {
+	other, ok := o.(MetricsServerValue)
 
 	if !ok {
 		return false
@@ -3129,65 +3743,40 @@ func (v CustomRegistryValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.CertPath.Equal(other.CertPath) {
-		return false
-	}
-
-	if !v.Password.Equal(other.Password) {
-		return false
-	}
-
-	if !v.RepoPath.Equal(other.RepoPath) {
-		return false
-	}
-
-	if !v.SelfSignedCerts.Equal(other.SelfSignedCerts) {
-		return false
-	}
-
-	if !v.SkipTls.Equal(other.SkipTls) {
-		return false
-	}
-
-	if !v.Url.Equal(other.Url) {
+	if !v.CpuLimit.Equal(other.CpuLimit) {
 		return false
 	}
 
-	if !v.Username.Equal(other.Username) {
+	if !v.MemoryLimit.Equal(other.MemoryLimit) {
 		return false
 	}
 
 	return true
 }
 
-func (v CustomRegistryValue) Type(ctx context.Context) attr.Type {
-	return CustomRegistryType{
+func (v MetricsServerValue) Type(ctx context.Context) attr.Type {
+	return MetricsServerType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v CustomRegistryValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v MetricsServerValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"cert_path":         basetypes.StringType{},
-		"password":          basetypes.StringType{},
-		"repo_path":         basetypes.StringType{},
-		"self_signed_certs": basetypes.BoolType{},
-		"skip_tls":          basetypes.BoolType{},
-		"url":               basetypes.StringType{},
-		"username":          basetypes.StringType{},
+		"cpu_limit":    basetypes.StringType{},
+		"memory_limit": basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = EtcdType{}
+var _ basetypes.ObjectTypable = MonitoringType{}
 
-type EtcdType struct {
+type MonitoringType struct {
 	basetypes.ObjectType
 }
 
-func (t EtcdType) Equal(o attr.Type) bool {
-	other, ok := o.(EtcdType)
+func (t MonitoringType) Equal(o attr.Type) bool {
+	other, ok := o.(MonitoringType)
 
 	if !ok {
 		return false
@@ -3196,132 +3785,94 @@ func (t EtcdType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t EtcdType) String() string {
-	return "EtcdType"
+func (t MonitoringType) String() string {
+	return "MonitoringType"
 }
 
-func (t EtcdType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t MonitoringType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	dataDirAttribute, ok := attributes["data_dir"]
+	pvcSizeAttribute, ok := attributes["pvc_size"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`data_dir is missing from object`)
+			`pvc_size is missing from object`)
 
 		return nil, diags
 	}
 
-	dataDirVal, ok := dataDirAttribute.(basetypes.StringValue)
+	pvcSizeVal, ok := pvcSizeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`data_dir expected to be basetypes.StringValue, was: %T`, dataDirAttribute))
+			fmt.Sprintf(`pvc_size expected to be basetypes.StringValue, was: %T`, pvcSizeAttribute))
 	}
 
-	electionTimeoutMsAttribute, ok := attributes["election_timeout_ms"]
+	retentionTimeAttribute, ok := attributes["retention_time"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`election_timeout_ms is missing from object`)
+			`retention_time is missing from object`)
 
 		return nil, diags
 	}
 
-	electionTimeoutMsVal, ok := electionTimeoutMsAttribute.(basetypes.Int64Value)
+	retentionTimeVal, ok := retentionTimeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`election_timeout_ms expected to be basetypes.Int64Value, was: %T`, electionTimeoutMsAttribute))
+			fmt.Sprintf(`retention_time expected to be basetypes.StringValue, was: %T`, retentionTimeAttribute))
 	}
 
-	enableEncryptionAttribute, ok := attributes["enable_encryption"]
+	storageClassNameAttribute, ok := attributes["storage_class_name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`enable_encryption is missing from object`)
+			`storage_class_name is missing from object`)
 
 		return nil, diags
 	}
 
-	enableEncryptionVal, ok := enableEncryptionAttribute.(basetypes.BoolValue)
+	storageClassNameVal, ok := storageClassNameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`enable_encryption expected to be basetypes.BoolValue, was: %T`, enableEncryptionAttribute))
+			fmt.Sprintf(`storage_class_name expected to be basetypes.StringValue, was: %T`, storageClassNameAttribute))
 	}
 
-	heartbeatIntervalMsAttribute, ok := attributes["heartbeat_interval_ms"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`heartbeat_interval_ms is missing from object`)
-
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	heartbeatIntervalMsVal, ok := heartbeatIntervalMsAttribute.(basetypes.Int64Value)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`heartbeat_interval_ms expected to be basetypes.Int64Value, was: %T`, heartbeatIntervalMsAttribute))
-	}
-
-	versionAttribute, ok := attributes["version"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`version is missing from object`)
-
-		return nil, diags
-	}
-
-	versionVal, ok := versionAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
-	}
-
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	return EtcdValue{
-		DataDir:             dataDirVal,
-		ElectionTimeoutMs:   electionTimeoutMsVal,
-		EnableEncryption:    enableEncryptionVal,
-		HeartbeatIntervalMs: heartbeatIntervalMsVal,
-		Version:             versionVal,
-		state:               attr.ValueStateKnown,
+	return MonitoringValue{
+		PvcSize:          pvcSizeVal,
+		RetentionTime:    retentionTimeVal,
+		StorageClassName: storageClassNameVal,
+		state:            attr.ValueStateKnown,
 	}, diags
 }
 
-func NewEtcdValueNull() EtcdValue {
-	return EtcdValue{
+func NewMonitoringValueNull() MonitoringValue {
+	return MonitoringValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewEtcdValueUnknown() EtcdValue {
-	return EtcdValue{
+func NewMonitoringValueUnknown() MonitoringValue {
+	return MonitoringValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewEtcdValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (EtcdValue, diag.Diagnostics) {
+func NewMonitoringValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (MonitoringValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -3332,11 +3883,11 @@ func NewEtcdValue(attributeTypes map[string]attr.Type, attributes map[string]att
 
 		if !ok {
 			diags.AddError(
-				"Missing EtcdValue Attribute Value",
-				"While creating a EtcdValue value, a missing attribute value was detected. "+
-					"A EtcdValue must contain values for all attributes, even if null or unknown. "+
+				"Missing MonitoringValue Attribute Value",
+				"While creating a MonitoringValue value, a missing attribute value was detected. "+
+					"A MonitoringValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("EtcdValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("MonitoringValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -3344,12 +3895,12 @@ func NewEtcdValue(attributeTypes map[string]attr.Type, attributes map[string]att
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid EtcdValue Attribute Type",
-				"While creating a EtcdValue value, an invalid attribute value was detected. "+
-					"A EtcdValue must use a matching attribute type for the value. "+
+				"Invalid MonitoringValue Attribute Type",
+				"While creating a MonitoringValue value, an invalid attribute value was detected. "+
+					"A MonitoringValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("EtcdValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("EtcdValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("MonitoringValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("MonitoringValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -3359,125 +3910,87 @@ func NewEtcdValue(attributeTypes map[string]attr.Type, attributes map[string]att
 
 		if !ok {
 			diags.AddError(
-				"Extra EtcdValue Attribute Value",
-				"While creating a EtcdValue value, an extra attribute value was detected. "+
-					"A EtcdValue must not contain values beyond the expected attribute types. "+
+				"Extra MonitoringValue Attribute Value",
+				"While creating a MonitoringValue value, an extra attribute value was detected. "+
+					"A MonitoringValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra EtcdValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra MonitoringValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewEtcdValueUnknown(), diags
-	}
-
-	dataDirAttribute, ok := attributes["data_dir"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`data_dir is missing from object`)
-
-		return NewEtcdValueUnknown(), diags
-	}
-
-	dataDirVal, ok := dataDirAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`data_dir expected to be basetypes.StringValue, was: %T`, dataDirAttribute))
-	}
-
-	electionTimeoutMsAttribute, ok := attributes["election_timeout_ms"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`election_timeout_ms is missing from object`)
-
-		return NewEtcdValueUnknown(), diags
-	}
-
-	electionTimeoutMsVal, ok := electionTimeoutMsAttribute.(basetypes.Int64Value)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`election_timeout_ms expected to be basetypes.Int64Value, was: %T`, electionTimeoutMsAttribute))
+		return NewMonitoringValueUnknown(), diags
 	}
 
-	enableEncryptionAttribute, ok := attributes["enable_encryption"]
+	pvcSizeAttribute, ok := attributes["pvc_size"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`enable_encryption is missing from object`)
+			`pvc_size is missing from object`)
 
-		return NewEtcdValueUnknown(), diags
+		return NewMonitoringValueUnknown(), diags
 	}
 
-	enableEncryptionVal, ok := enableEncryptionAttribute.(basetypes.BoolValue)
+	pvcSizeVal, ok := pvcSizeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`enable_encryption expected to be basetypes.BoolValue, was: %T`, enableEncryptionAttribute))
+			fmt.Sprintf(`pvc_size expected to be basetypes.StringValue, was: %T`, pvcSizeAttribute))
 	}
 
-	heartbeatIntervalMsAttribute, ok := attributes["heartbeat_interval_ms"]
+	retentionTimeAttribute, ok := attributes["retention_time"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`heartbeat_interval_ms is missing from object`)
+			`retention_time is missing from object`)
 
-		return NewEtcdValueUnknown(), diags
+		return NewMonitoringValueUnknown(), diags
 	}
 
-	heartbeatIntervalMsVal, ok := heartbeatIntervalMsAttribute.(basetypes.Int64Value)
+	retentionTimeVal, ok := retentionTimeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`heartbeat_interval_ms expected to be basetypes.Int64Value, was: %T`, heartbeatIntervalMsAttribute))
+			fmt.Sprintf(`retention_time expected to be basetypes.StringValue, was: %T`, retentionTimeAttribute))
 	}
 
-	versionAttribute, ok := attributes["version"]
+	storageClassNameAttribute, ok := attributes["storage_class_name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`version is missing from object`)
+			`storage_class_name is missing from object`)
 
-		return NewEtcdValueUnknown(), diags
+		return NewMonitoringValueUnknown(), diags
 	}
 
-	versionVal, ok := versionAttribute.(basetypes.StringValue)
+	storageClassNameVal, ok := storageClassNameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
+			fmt.Sprintf(`storage_class_name expected to be basetypes.StringValue, was: %T`, storageClassNameAttribute))
 	}
 
 	if diags.HasError() {
-		return NewEtcdValueUnknown(), diags
+		return NewMonitoringValueUnknown(), diags
 	}
 
-	return EtcdValue{
-		DataDir:             dataDirVal,
-		ElectionTimeoutMs:   electionTimeoutMsVal,
-		EnableEncryption:    enableEncryptionVal,
-		HeartbeatIntervalMs: heartbeatIntervalMsVal,
-		Version:             versionVal,
-		state:               attr.ValueStateKnown,
+	return MonitoringValue{
+		PvcSize:          pvcSizeVal,
+		RetentionTime:    retentionTimeVal,
+		StorageClassName: storageClassNameVal,
+		state:            attr.ValueStateKnown,
 	}, diags
 }
 
-func NewEtcdValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) EtcdValue {
-	object, diags := NewEtcdValue(attributeTypes, attributes)
+func NewMonitoringValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) MonitoringValue {
+	object, diags := NewMonitoringValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -3491,15 +4004,15 @@ func NewEtcdValueMust(attributeTypes map[string]attr.Type, attributes map[string
 				diagnostic.Detail()))
 		}
 
-		panic("NewEtcdValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewMonitoringValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t EtcdType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t MonitoringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewEtcdValueNull(), nil
+		return NewMonitoringValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -3507,11 +4020,11 @@ func (t EtcdType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	}
 
 	if !in.IsKnown() {
-		return NewEtcdValueUnknown(), nil
+		return NewMonitoringValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewEtcdValueNull(), nil
+		return NewMonitoringValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -3534,81 +4047,61 @@ func (t EtcdType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 		attributes[k] = a
 	}
 
-	return NewEtcdValueMust(EtcdValue{}.AttributeTypes(ctx), attributes), nil
+	return NewMonitoringValueMust(MonitoringValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t EtcdType) ValueType(ctx context.Context) attr.Value {
-	return EtcdValue{}
+func (t MonitoringType) ValueType(ctx context.Context) attr.Value {
+	return MonitoringValue{}
 }
 
-var _ basetypes.ObjectValuable = EtcdValue{}
+var _ basetypes.ObjectValuable = MonitoringValue{}
 
-type EtcdValue struct {
-	DataDir             basetypes.StringValue `tfsdk:"data_dir"`
-	ElectionTimeoutMs   basetypes.Int64Value  `tfsdk:"election_timeout_ms"`
-	EnableEncryption    basetypes.BoolValue   `tfsdk:"enable_encryption"`
-	HeartbeatIntervalMs basetypes.Int64Value  `tfsdk:"heartbeat_interval_ms"`
-	Version             basetypes.StringValue `tfsdk:"version"`
-	state               attr.ValueState
+type MonitoringValue struct {
+	PvcSize          basetypes.StringValue `tfsdk:"pvc_size"`
+	RetentionTime    basetypes.StringValue `tfsdk:"retention_time"`
+	StorageClassName basetypes.StringValue `tfsdk:"storage_class_name"`
+	state            attr.ValueState
 }
 
-func (v EtcdValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 5)
+func (v MonitoringValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["data_dir"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["election_timeout_ms"] = basetypes.Int64Type{}.TerraformType(ctx)
-	attrTypes["enable_encryption"] = basetypes.BoolType{}.TerraformType(ctx)
-	attrTypes["heartbeat_interval_ms"] = basetypes.Int64Type{}.TerraformType(ctx)
-	attrTypes["version"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["pvc_size"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["retention_time"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["storage_class_name"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 5)
-
-		val, err = v.DataDir.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["data_dir"] = val
-
-		val, err = v.ElectionTimeoutMs.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["election_timeout_ms"] = val
+		vals := make(map[string]tftypes.Value, 3)
 
-		val, err = v.EnableEncryption.ToTerraformValue(ctx)
+		val, err = v.PvcSize.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["enable_encryption"] = val
+		vals["pvc_size"] = val
 
-		val, err = v.HeartbeatIntervalMs.ToTerraformValue(ctx)
+		val, err = v.RetentionTime.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["heartbeat_interval_ms"] = val
+		vals["retention_time"] = val
 
-		val, err = v.Version.ToTerraformValue(ctx)
+		val, err = v.StorageClassName.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["version"] = val
+		vals["storage_class_name"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -3624,42 +4117,38 @@ func (v EtcdValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 	}
 }
 
-func (v EtcdValue) IsNull() bool {
+func (v MonitoringValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v EtcdValue) IsUnknown() bool {
+func (v MonitoringValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v EtcdValue) String() string {
-	return "EtcdValue"
+func (v MonitoringValue) String() string {
+	return "MonitoringValue"
 }
 
-func (v EtcdValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v MonitoringValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"data_dir":              basetypes.StringType{},
-			"election_timeout_ms":   basetypes.Int64Type{},
-			"enable_encryption":     basetypes.BoolType{},
-			"heartbeat_interval_ms": basetypes.Int64Type{},
-			"version":               basetypes.StringType{},
+			"pvc_size":           basetypes.StringType{},
+			"retention_time":     basetypes.StringType{},
+			"storage_class_name": basetypes.StringType{},
 		},
 		map[string]attr.Value{
-			"data_dir":              v.DataDir,
-			"election_timeout_ms":   v.ElectionTimeoutMs,
-			"enable_encryption":     v.EnableEncryption,
-			"heartbeat_interval_ms": v.HeartbeatIntervalMs,
-			"version":               v.Version,
+			"pvc_size":           v.PvcSize,
+			"retention_time":     v.RetentionTime,
+			"storage_class_name": v.StorageClassName,
 		})
 
 	return objVal, diags
 }
 
-func (v EtcdValue) Equal(o attr.Value) bool {
-	other, ok := o.(EtcdValue)
+func (v MonitoringValue) Equal(o attr.Value) bool {
+	other, ok := o.(MonitoringValue)
 
 	if !ok {
 		return false
@@ -3673,55 +4162,45 @@ func (v EtcdValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.DataDir.Equal(other.DataDir) {
-		return false
-	}
-
-	if !v.ElectionTimeoutMs.Equal(other.ElectionTimeoutMs) {
-		return false
-	}
-
-	if !v.EnableEncryption.Equal(other.EnableEncryption) {
+	if !v.PvcSize.Equal(other.PvcSize) {
 		return false
 	}
 
-	if !v.HeartbeatIntervalMs.Equal(other.HeartbeatIntervalMs) {
+	if !v.RetentionTime.Equal(other.RetentionTime) {
 		return false
 	}
 
-	if !v.Version.Equal(other.Version) {
+	if !v.StorageClassName.Equal(other.StorageClassName) {
 		return false
 	}
 
 	return true
 }
 
-func (v EtcdValue) Type(ctx context.Context) attr.Type {
-	return EtcdType{
+func (v MonitoringValue) Type(ctx context.Context) attr.Type {
+	return MonitoringType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v EtcdValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v MonitoringValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"data_dir":              basetypes.StringType{},
-		"election_timeout_ms":   basetypes.Int64Type{},
-		"enable_encryption":     basetypes.BoolType{},
-		"heartbeat_interval_ms": basetypes.Int64Type{},
-		"version":               basetypes.StringType{},
+		"pvc_size":           basetypes.StringType{},
+		"retention_time":     basetypes.StringType{},
+		"storage_class_name": basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = EtcdBackupType{}
+var _ basetypes.ObjectTypable = CalicoLimitsType{}
 
-type EtcdBackupType struct {
+type CalicoLimitsType struct {
 	basetypes.ObjectType
 }
 
-func (t EtcdBackupType) Equal(o attr.Type) bool {
-	other, ok := o.(EtcdBackupType)
+func (t CalicoLimitsType) Equal(o attr.Type) bool {
+	other, ok := o.(CalicoLimitsType)
 
 	if !ok {
 		return false
@@ -3730,113 +4209,151 @@ func (t EtcdBackupType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t EtcdBackupType) String() string {
-	return "EtcdBackupType"
+func (t CalicoLimitsType) String() string {
+	return "CalicoLimitsType"
 }
 
-func (t EtcdBackupType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t CalicoLimitsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	dailyAttribute, ok := attributes["daily"]
+	controllerCpuLimitAttribute, ok := attributes["controller_cpu_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`daily is missing from object`)
+			`controller_cpu_limit is missing from object`)
 
 		return nil, diags
 	}
 
-	dailyVal, ok := dailyAttribute.(basetypes.ObjectValue)
+	controllerCpuLimitVal, ok := controllerCpuLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`daily expected to be basetypes.ObjectValue, was: %T`, dailyAttribute))
+			fmt.Sprintf(`controller_cpu_limit expected to be basetypes.StringValue, was: %T`, controllerCpuLimitAttribute))
 	}
 
-	intervalAttribute, ok := attributes["interval"]
+	controllerMemoryLimitAttribute, ok := attributes["controller_memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`interval is missing from object`)
+			`controller_memory_limit is missing from object`)
 
 		return nil, diags
 	}
 
-	intervalVal, ok := intervalAttribute.(basetypes.ObjectValue)
+	controllerMemoryLimitVal, ok := controllerMemoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`interval expected to be basetypes.ObjectValue, was: %T`, intervalAttribute))
+			fmt.Sprintf(`controller_memory_limit expected to be basetypes.StringValue, was: %T`, controllerMemoryLimitAttribute))
 	}
 
-	storageLocalPathAttribute, ok := attributes["storage_local_path"]
+	nodeCpuLimitAttribute, ok := attributes["node_cpu_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`storage_local_path is missing from object`)
+			`node_cpu_limit is missing from object`)
 
 		return nil, diags
 	}
 
-	storageLocalPathVal, ok := storageLocalPathAttribute.(basetypes.StringValue)
+	nodeCpuLimitVal, ok := nodeCpuLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`storage_local_path expected to be basetypes.StringValue, was: %T`, storageLocalPathAttribute))
+			fmt.Sprintf(`node_cpu_limit expected to be basetypes.StringValue, was: %T`, nodeCpuLimitAttribute))
 	}
 
-	storageTypeAttribute, ok := attributes["storage_type"]
+	nodeMemoryLimitAttribute, ok := attributes["node_memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`storage_type is missing from object`)
+			`node_memory_limit is missing from object`)
 
 		return nil, diags
 	}
 
-	storageTypeVal, ok := storageTypeAttribute.(basetypes.StringValue)
+	nodeMemoryLimitVal, ok := nodeMemoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`storage_type expected to be basetypes.StringValue, was: %T`, storageTypeAttribute))
+			fmt.Sprintf(`node_memory_limit expected to be basetypes.StringValue, was: %T`, nodeMemoryLimitAttribute))
 	}
 
-	if diags.HasError() {
-		return nil, diags
-	}
+	typhaCpuLimitAttribute, ok := attributes["typha_cpu_limit"]
 
-	return EtcdBackupValue{
-		Daily:            dailyVal,
-		Interval:         intervalVal,
-		StorageLocalPath: storageLocalPathVal,
-		StorageType:      storageTypeVal,
-		state:            attr.ValueStateKnown,
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`typha_cpu_limit is missing from object`)
+
+		return nil, diags
+	}
+
+	typhaCpuLimitVal, ok := typhaCpuLimitAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`typha_cpu_limit expected to be basetypes.StringValue, was: %T`, typhaCpuLimitAttribute))
+	}
+
+	typhaMemoryLimitAttribute, ok := attributes["typha_memory_limit"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`typha_memory_limit is missing from object`)
+
+		return nil, diags
+	}
+
+	typhaMemoryLimitVal, ok := typhaMemoryLimitAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`typha_memory_limit expected to be basetypes.StringValue, was: %T`, typhaMemoryLimitAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return CalicoLimitsValue{
+		ControllerCpuLimit:    controllerCpuLimitVal,
+		ControllerMemoryLimit: controllerMemoryLimitVal,
+		NodeCpuLimit:          nodeCpuLimitVal,
+		NodeMemoryLimit:       nodeMemoryLimitVal,
+		TyphaCpuLimit:         typhaCpuLimitVal,
+		TyphaMemoryLimit:      typhaMemoryLimitVal,
+		state:                 attr.ValueStateKnown,
 	}, diags
 }
 
-func NewEtcdBackupValueNull() EtcdBackupValue {
-	return EtcdBackupValue{
+func NewCalicoLimitsValueNull() CalicoLimitsValue {
+	return CalicoLimitsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewEtcdBackupValueUnknown() EtcdBackupValue {
-	return EtcdBackupValue{
+func NewCalicoLimitsValueUnknown() CalicoLimitsValue {
+	return CalicoLimitsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewEtcdBackupValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (EtcdBackupValue, diag.Diagnostics) {
+func NewCalicoLimitsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CalicoLimitsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -3847,11 +4364,11 @@ func NewEtcdBackupValue(attributeTypes map[string]attr.Type, attributes map[stri
 
 		if !ok {
 			diags.AddError(
-				"Missing EtcdBackupValue Attribute Value",
-				"While creating a EtcdBackupValue value, a missing attribute value was detected. "+
-					"A EtcdBackupValue must contain values for all attributes, even if null or unknown. "+
+				"Missing CalicoLimitsValue Attribute Value",
+				"While creating a CalicoLimitsValue value, a missing attribute value was detected. "+
+					"A CalicoLimitsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("EtcdBackupValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("CalicoLimitsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -3859,12 +4376,12 @@ func NewEtcdBackupValue(attributeTypes map[string]attr.Type, attributes map[stri
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid EtcdBackupValue Attribute Type",
-				"While creating a EtcdBackupValue value, an invalid attribute value was detected. "+
-					"A EtcdBackupValue must use a matching attribute type for the value. "+
+				"Invalid CalicoLimitsValue Attribute Type",
+				"While creating a CalicoLimitsValue value, an invalid attribute value was detected. "+
+					"A CalicoLimitsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("EtcdBackupValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("EtcdBackupValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("CalicoLimitsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("CalicoLimitsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -3874,106 +4391,144 @@ func NewEtcdBackupValue(attributeTypes map[string]attr.Type, attributes map[stri
 
 		if !ok {
 			diags.AddError(
-				"Extra EtcdBackupValue Attribute Value",
-				"While creating a EtcdBackupValue value, an extra attribute value was detected. "+
-					"A EtcdBackupValue must not contain values beyond the expected attribute types. "+
+				"Extra CalicoLimitsValue Attribute Value",
+				"While creating a CalicoLimitsValue value, an extra attribute value was detected. "+
+					"A CalicoLimitsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra EtcdBackupValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra CalicoLimitsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewEtcdBackupValueUnknown(), diags
+		return NewCalicoLimitsValueUnknown(), diags
 	}
 
-	dailyAttribute, ok := attributes["daily"]
+	controllerCpuLimitAttribute, ok := attributes["controller_cpu_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`daily is missing from object`)
+			`controller_cpu_limit is missing from object`)
 
-		return NewEtcdBackupValueUnknown(), diags
+		return NewCalicoLimitsValueUnknown(), diags
 	}
 
-	dailyVal, ok := dailyAttribute.(basetypes.ObjectValue)
+	controllerCpuLimitVal, ok := controllerCpuLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`daily expected to be basetypes.ObjectValue, was: %T`, dailyAttribute))
+			fmt.Sprintf(`controller_cpu_limit expected to be basetypes.StringValue, was: %T`, controllerCpuLimitAttribute))
 	}
 
-	intervalAttribute, ok := attributes["interval"]
+	controllerMemoryLimitAttribute, ok := attributes["controller_memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`interval is missing from object`)
+			`controller_memory_limit is missing from object`)
 
-		return NewEtcdBackupValueUnknown(), diags
+		return NewCalicoLimitsValueUnknown(), diags
 	}
 
-	intervalVal, ok := intervalAttribute.(basetypes.ObjectValue)
+	controllerMemoryLimitVal, ok := controllerMemoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`interval expected to be basetypes.ObjectValue, was: %T`, intervalAttribute))
+			fmt.Sprintf(`controller_memory_limit expected to be basetypes.StringValue, was: %T`, controllerMemoryLimitAttribute))
 	}
 
-	storageLocalPathAttribute, ok := attributes["storage_local_path"]
+	nodeCpuLimitAttribute, ok := attributes["node_cpu_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`storage_local_path is missing from object`)
+			`node_cpu_limit is missing from object`)
 
-		return NewEtcdBackupValueUnknown(), diags
+		return NewCalicoLimitsValueUnknown(), diags
 	}
 
-	storageLocalPathVal, ok := storageLocalPathAttribute.(basetypes.StringValue)
+	nodeCpuLimitVal, ok := nodeCpuLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`storage_local_path expected to be basetypes.StringValue, was: %T`, storageLocalPathAttribute))
+			fmt.Sprintf(`node_cpu_limit expected to be basetypes.StringValue, was: %T`, nodeCpuLimitAttribute))
 	}
 
-	storageTypeAttribute, ok := attributes["storage_type"]
+	nodeMemoryLimitAttribute, ok := attributes["node_memory_limit"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`storage_type is missing from object`)
+			`node_memory_limit is missing from object`)
 
-		return NewEtcdBackupValueUnknown(), diags
+		return NewCalicoLimitsValueUnknown(), diags
 	}
 
-	storageTypeVal, ok := storageTypeAttribute.(basetypes.StringValue)
+	nodeMemoryLimitVal, ok := nodeMemoryLimitAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`storage_type expected to be basetypes.StringValue, was: %T`, storageTypeAttribute))
+			fmt.Sprintf(`node_memory_limit expected to be basetypes.StringValue, was: %T`, nodeMemoryLimitAttribute))
+	}
+
+	typhaCpuLimitAttribute, ok := attributes["typha_cpu_limit"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`typha_cpu_limit is missing from object`)
+
+		return NewCalicoLimitsValueUnknown(), diags
+	}
+
+	typhaCpuLimitVal, ok := typhaCpuLimitAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`typha_cpu_limit expected to be basetypes.StringValue, was: %T`, typhaCpuLimitAttribute))
+	}
+
+	typhaMemoryLimitAttribute, ok := attributes["typha_memory_limit"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`typha_memory_limit is missing from object`)
+
+		return NewCalicoLimitsValueUnknown(), diags
+	}
+
+	typhaMemoryLimitVal, ok := typhaMemoryLimitAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`typha_memory_limit expected to be basetypes.StringValue, was: %T`, typhaMemoryLimitAttribute))
 	}
 
 	if diags.HasError() {
-		return NewEtcdBackupValueUnknown(), diags
+		return NewCalicoLimitsValueUnknown(), diags
 	}
 
-	return EtcdBackupValue{
-		Daily:            dailyVal,
-		Interval:         intervalVal,
-		StorageLocalPath: storageLocalPathVal,
-		StorageType:      storageTypeVal,
-		state:            attr.ValueStateKnown,
+	return CalicoLimitsValue{
+		ControllerCpuLimit:    controllerCpuLimitVal,
+		ControllerMemoryLimit: controllerMemoryLimitVal,
+		NodeCpuLimit:          nodeCpuLimitVal,
+		NodeMemoryLimit:       nodeMemoryLimitVal,
+		TyphaCpuLimit:         typhaCpuLimitVal,
+		TyphaMemoryLimit:      typhaMemoryLimitVal,
+		state:                 attr.ValueStateKnown,
 	}, diags
 }
 
-func NewEtcdBackupValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) EtcdBackupValue {
-	object, diags := NewEtcdBackupValue(attributeTypes, attributes)
+func NewCalicoLimitsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CalicoLimitsValue {
+	object, diags := NewCalicoLimitsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -3987,15 +4542,15 @@ func NewEtcdBackupValueMust(attributeTypes map[string]attr.Type, attributes map[
 				diagnostic.Detail()))
 		}
 
-		panic("NewEtcdBackupValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewCalicoLimitsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t EtcdBackupType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t CalicoLimitsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewEtcdBackupValueNull(), nil
+		return NewCalicoLimitsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -4003,11 +4558,11 @@ func (t EtcdBackupType) ValueFromTerraform(ctx context.Context, in tftypes.Value
 	}
 
 	if !in.IsKnown() {
-		return NewEtcdBackupValueUnknown(), nil
+		return NewCalicoLimitsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewEtcdBackupValueNull(), nil
+		return NewCalicoLimitsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -4030,77 +4585,93 @@ func (t EtcdBackupType) ValueFromTerraform(ctx context.Context, in tftypes.Value
 		attributes[k] = a
 	}
 
-	return NewEtcdBackupValueMust(EtcdBackupValue{}.AttributeTypes(ctx), attributes), nil
+	return NewCalicoLimitsValueMust(CalicoLimitsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t EtcdBackupType) ValueType(ctx context.Context) attr.Value {
-	return EtcdBackupValue{}
+func (t CalicoLimitsType) ValueType(ctx context.Context) attr.Value {
+	return CalicoLimitsValue{}
 }
 
-var _ basetypes.ObjectValuable = EtcdBackupValue{}
+var _ basetypes.ObjectValuable = CalicoLimitsValue{}
 
-type EtcdBackupValue struct {
-	Daily            basetypes.ObjectValue `tfsdk:"daily"`
-	Interval         basetypes.ObjectValue `tfsdk:"interval"`
-	StorageLocalPath basetypes.StringValue `tfsdk:"storage_local_path"`
-	StorageType      basetypes.StringValue `tfsdk:"storage_type"`
-	state            attr.ValueState
+type CalicoLimitsValue struct {
+	ControllerCpuLimit    basetypes.StringValue `tfsdk:"controller_cpu_limit"`
+	ControllerMemoryLimit basetypes.StringValue `tfsdk:"controller_memory_limit"`
+	NodeCpuLimit          basetypes.StringValue `tfsdk:"node_cpu_limit"`
+	NodeMemoryLimit       basetypes.StringValue `tfsdk:"node_memory_limit"`
+	TyphaCpuLimit         basetypes.StringValue `tfsdk:"typha_cpu_limit"`
+	TyphaMemoryLimit      basetypes.StringValue `tfsdk:"typha_memory_limit"`
+	state                 attr.ValueState
 }
 
-func (v EtcdBackupValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 4)
+func (v CalicoLimitsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 6)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["daily"] = basetypes.ObjectType{
-		AttrTypes: DailyValue{}.AttributeTypes(ctx),
-	}.TerraformType(ctx)
-	attrTypes["interval"] = basetypes.ObjectType{
-		AttrTypes: IntervalValue{}.AttributeTypes(ctx),
-	}.TerraformType(ctx)
-	attrTypes["storage_local_path"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["storage_type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["controller_cpu_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["controller_memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["node_cpu_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["node_memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["typha_cpu_limit"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["typha_memory_limit"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 4)
+		vals := make(map[string]tftypes.Value, 6)
 
-		val, err = v.Daily.ToTerraformValue(ctx)
+		val, err = v.ControllerCpuLimit.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["daily"] = val
+		vals["controller_cpu_limit"] = val
 
-		val, err = v.Interval.ToTerraformValue(ctx)
+		val, err = v.ControllerMemoryLimit.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["interval"] = val
+		vals["controller_memory_limit"] = val
 
-		val, err = v.StorageLocalPath.ToTerraformValue(ctx)
+		val, err = v.NodeCpuLimit.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["storage_local_path"] = val
+		vals["node_cpu_limit"] = val
 
-		val, err = v.StorageType.ToTerraformValue(ctx)
+		val, err = v.NodeMemoryLimit.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["storage_type"] = val
+		vals["node_memory_limit"] = val
 
-		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+		val, err = v.TyphaCpuLimit.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["typha_cpu_limit"] = val
+
+		val, err = v.TyphaMemoryLimit.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["typha_memory_limit"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
@@ -4114,86 +4685,44 @@ func (v EtcdBackupValue) ToTerraformValue(ctx context.Context) (tftypes.Value, e
 	}
 }
 
-func (v EtcdBackupValue) IsNull() bool {
+func (v CalicoLimitsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v EtcdBackupValue) IsUnknown() bool {
+func (v CalicoLimitsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v EtcdBackupValue) String() string {
-	return "EtcdBackupValue"
+func (v CalicoLimitsValue) String() string {
+	return "CalicoLimitsValue"
 }
 
-func (v EtcdBackupValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v CalicoLimitsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	var daily basetypes.ObjectValue
-
-	if v.Daily.IsNull() {
-		daily = types.ObjectNull(
-			DailyValue{}.AttributeTypes(ctx),
-		)
-	}
-
-	if v.Daily.IsUnknown() {
-		daily = types.ObjectUnknown(
-			DailyValue{}.AttributeTypes(ctx),
-		)
-	}
-
-	if !v.Daily.IsNull() && !v.Daily.IsUnknown() {
-		daily = types.ObjectValueMust(
-			DailyValue{}.AttributeTypes(ctx),
-			v.Daily.Attributes(),
-		)
-	}
-
-	var interval basetypes.ObjectValue
-
-	if v.Interval.IsNull() {
-		interval = types.ObjectNull(
-			IntervalValue{}.AttributeTypes(ctx),
-		)
-	}
-
-	if v.Interval.IsUnknown() {
-		interval = types.ObjectUnknown(
-			IntervalValue{}.AttributeTypes(ctx),
-		)
-	}
-
-	if !v.Interval.IsNull() && !v.Interval.IsUnknown() {
-		interval = types.ObjectValueMust(
-			IntervalValue{}.AttributeTypes(ctx),
-			v.Interval.Attributes(),
-		)
-	}
-
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"daily": basetypes.ObjectType{
-				AttrTypes: DailyValue{}.AttributeTypes(ctx),
-			},
-			"interval": basetypes.ObjectType{
-				AttrTypes: IntervalValue{}.AttributeTypes(ctx),
-			},
-			"storage_local_path": basetypes.StringType{},
-			"storage_type":       basetypes.StringType{},
+			"controller_cpu_limit":    basetypes.StringType{},
+			"controller_memory_limit": basetypes.StringType{},
+			"node_cpu_limit":          basetypes.StringType{},
+			"node_memory_limit":       basetypes.StringType{},
+			"typha_cpu_limit":         basetypes.StringType{},
+			"typha_memory_limit":      basetypes.StringType{},
 		},
 		map[string]attr.Value{
-			"daily":              daily,
-			"interval":           interval,
-			"storage_local_path": v.StorageLocalPath,
-			"storage_type":       v.StorageType,
+			"controller_cpu_limit":    v.ControllerCpuLimit,
+			"controller_memory_limit": v.ControllerMemoryLimit,
+			"node_cpu_limit":          v.NodeCpuLimit,
+			"node_memory_limit":       v.NodeMemoryLimit,
+			"typha_cpu_limit":         v.TyphaCpuLimit,
+			"typha_memory_limit":      v.TyphaMemoryLimit,
 		})
 
 	return objVal, diags
 }
 
-func (v EtcdBackupValue) Equal(o attr.Value) bool {
-	other, ok := o.(EtcdBackupValue)
+func (v CalicoLimitsValue) Equal(o attr.Value) bool {
+	other, ok := o.(CalicoLimitsValue)
 
 	if !ok {
 		return false
@@ -4207,54 +4736,60 @@ func (v EtcdBackupValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.Daily.Equal(other.Daily) {
+	if !v.ControllerCpuLimit.Equal(other.ControllerCpuLimit) {
 		return false
 	}
 
-	if !v.Interval.Equal(other.Interval) {
+	if !v.ControllerMemoryLimit.Equal(other.ControllerMemoryLimit) {
 		return false
 	}
 
-	if !v.StorageLocalPath.Equal(other.StorageLocalPath) {
+	if !v.NodeCpuLimit.Equal(other.NodeCpuLimit) {
 		return false
 	}
 
-	if !v.StorageType.Equal(other.StorageType) {
+	if !v.NodeMemoryLimit.Equal(other.NodeMemoryLimit) {
+		return false
+	}
+
+	if !v.TyphaCpuLimit.Equal(other.TyphaCpuLimit) {
+		return false
+	}
+
+	if !v.TyphaMemoryLimit.Equal(other.TyphaMemoryLimit) {
 		return false
 	}
 
 	return true
 }
 
-func (v EtcdBackupValue) Type(ctx context.Context) attr.Type {
-	return EtcdBackupType{
+func (v CalicoLimitsValue) Type(ctx context.Context) attr.Type {
+	return CalicoLimitsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v EtcdBackupValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v CalicoLimitsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"daily": basetypes.ObjectType{
-			AttrTypes: DailyValue{}.AttributeTypes(ctx),
-		},
-		"interval": basetypes.ObjectType{
-			AttrTypes: IntervalValue{}.AttributeTypes(ctx),
-		},
-		"storage_local_path": basetypes.StringType{},
-		"storage_type":       basetypes.StringType{},
+		"controller_cpu_limit":    basetypes.StringType{},
+		"controller_memory_limit": basetypes.StringType{},
+		"node_cpu_limit":          basetypes.StringType{},
+		"node_memory_limit":       basetypes.StringType{},
+		"typha_cpu_limit":         basetypes.StringType{},
+		"typha_memory_limit":      basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = DailyType{}
+var _ basetypes.ObjectTypable = CloudProviderType{}
 
-type DailyType struct {
+type CloudProviderType struct {
 	basetypes.ObjectType
 }
 
-func (t DailyType) Equal(o attr.Type) bool {
-	other, ok := o.(DailyType)
+func (t CloudProviderType) Equal(o attr.Type) bool {
+	other, ok := o.(CloudProviderType)
 
 	if !ok {
 		return false
@@ -4263,75 +4798,94 @@ func (t DailyType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t DailyType) String() string {
-	return "DailyType"
+func (t CloudProviderType) String() string {
+	return "CloudProviderType"
 }
 
-func (t DailyType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t CloudProviderType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	backupTimeAttribute, ok := attributes["backup_time"]
+	nameAttribute, ok := attributes["name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`backup_time is missing from object`)
+			`name is missing from object`)
 
 		return nil, diags
 	}
 
-	backupTimeVal, ok := backupTimeAttribute.(basetypes.StringValue)
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`backup_time expected to be basetypes.StringValue, was: %T`, backupTimeAttribute))
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+	typeAttribute, ok := attributes["type"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`max_backups_to_retain is missing from object`)
+			`type is missing from object`)
 
 		return nil, diags
 	}
 
-	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	uuidAttribute, ok := attributes["uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`uuid is missing from object`)
+
+		return nil, diags
+	}
+
+	uuidVal, ok := uuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`uuid expected to be basetypes.StringValue, was: %T`, uuidAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return DailyValue{
-		BackupTime:         backupTimeVal,
-		MaxBackupsToRetain: maxBackupsToRetainVal,
-		state:              attr.ValueStateKnown,
+	return CloudProviderValue{
+		Name:              nameVal,
+		CloudProviderType: typeVal,
+		Uuid:              uuidVal,
+		state:             attr.ValueStateKnown,
 	}, diags
 }
 
-func NewDailyValueNull() DailyValue {
-	return DailyValue{
+func NewCloudProviderValueNull() CloudProviderValue {
+	return CloudProviderValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewDailyValueUnknown() DailyValue {
-	return DailyValue{
+func NewCloudProviderValueUnknown() CloudProviderValue {
+	return CloudProviderValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewDailyValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (DailyValue, diag.Diagnostics) {
+func NewCloudProviderValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CloudProviderValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -4342,11 +4896,11 @@ func NewDailyValue(attributeTypes map[string]attr.Type, attributes map[string]at
 
 		if !ok {
 			diags.AddError(
-				"Missing DailyValue Attribute Value",
-				"While creating a DailyValue value, a missing attribute value was detected. "+
-					"A DailyValue must contain values for all attributes, even if null or unknown. "+
+				"Missing CloudProviderValue Attribute Value",
+				"While creating a CloudProviderValue value, a missing attribute value was detected. "+
+					"A CloudProviderValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("DailyValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("CloudProviderValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -4354,12 +4908,12 @@ func NewDailyValue(attributeTypes map[string]attr.Type, attributes map[string]at
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid DailyValue Attribute Type",
-				"While creating a DailyValue value, an invalid attribute value was detected. "+
-					"A DailyValue must use a matching attribute type for the value. "+
+				"Invalid CloudProviderValue Attribute Type",
+				"While creating a CloudProviderValue value, an invalid attribute value was detected. "+
+					"A CloudProviderValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("DailyValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("DailyValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("CloudProviderValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("CloudProviderValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -4369,68 +4923,87 @@ func NewDailyValue(attributeTypes map[string]attr.Type, attributes map[string]at
 
 		if !ok {
 			diags.AddError(
-				"Extra DailyValue Attribute Value",
-				"While creating a DailyValue value, an extra attribute value was detected. "+
-					"A DailyValue must not contain values beyond the expected attribute types. "+
+				"Extra CloudProviderValue Attribute Value",
+				"While creating a CloudProviderValue value, an extra attribute value was detected. "+
+					"A CloudProviderValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra DailyValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra CloudProviderValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewDailyValueUnknown(), diags
+		return NewCloudProviderValueUnknown(), diags
 	}
 
-	backupTimeAttribute, ok := attributes["backup_time"]
+	nameAttribute, ok := attributes["name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`backup_time is missing from object`)
+			`name is missing from object`)
 
-		return NewDailyValueUnknown(), diags
+		return NewCloudProviderValueUnknown(), diags
 	}
 
-	backupTimeVal, ok := backupTimeAttribute.(basetypes.StringValue)
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`backup_time expected to be basetypes.StringValue, was: %T`, backupTimeAttribute))
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+	typeAttribute, ok := attributes["type"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`max_backups_to_retain is missing from object`)
+			`type is missing from object`)
 
-		return NewDailyValueUnknown(), diags
+		return NewCloudProviderValueUnknown(), diags
 	}
 
-	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
 	}
 
-	if diags.HasError() {
-		return NewDailyValueUnknown(), diags
+	uuidAttribute, ok := attributes["uuid"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`uuid is missing from object`)
+
+		return NewCloudProviderValueUnknown(), diags
 	}
 
-	return DailyValue{
-		BackupTime:         backupTimeVal,
-		MaxBackupsToRetain: maxBackupsToRetainVal,
-		state:              attr.ValueStateKnown,
+	uuidVal, ok := uuidAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`uuid expected to be basetypes.StringValue, was: %T`, uuidAttribute))
+	}
+
+	if diags.HasError() {
+		return NewCloudProviderValueUnknown(), diags
+	}
+
+	return CloudProviderValue{
+		Name:              nameVal,
+		CloudProviderType: typeVal,
+		Uuid:              uuidVal,
+		state:             attr.ValueStateKnown,
 	}, diags
 }
 
-func NewDailyValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) DailyValue {
-	object, diags := NewDailyValue(attributeTypes, attributes)
+func NewCloudProviderValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CloudProviderValue {
+	object, diags := NewCloudProviderValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -4444,15 +5017,15 @@ func NewDailyValueMust(attributeTypes map[string]attr.Type, attributes map[strin
 				diagnostic.Detail()))
 		}
 
-		panic("NewDailyValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewCloudProviderValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t DailyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t CloudProviderType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewDailyValueNull(), nil
+		return NewCloudProviderValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -4460,11 +5033,11 @@ func (t DailyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 	}
 
 	if !in.IsKnown() {
-		return NewDailyValueUnknown(), nil
+		return NewCloudProviderValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewDailyValueNull(), nil
+		return NewCloudProviderValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -4487,51 +5060,61 @@ func (t DailyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 		attributes[k] = a
 	}
 
-	return NewDailyValueMust(DailyValue{}.AttributeTypes(ctx), attributes), nil
+	return NewCloudProviderValueMust(CloudProviderValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t DailyType) ValueType(ctx context.Context) attr.Value {
-	return DailyValue{}
+func (t CloudProviderType) ValueType(ctx context.Context) attr.Value {
+	return CloudProviderValue{}
 }
 
-var _ basetypes.ObjectValuable = DailyValue{}
+var _ basetypes.ObjectValuable = CloudProviderValue{}
 
-type DailyValue struct {
-	BackupTime         basetypes.StringValue `tfsdk:"backup_time"`
-	MaxBackupsToRetain basetypes.Int64Value  `tfsdk:"max_backups_to_retain"`
-	state              attr.ValueState
+type CloudProviderValue struct {
+	Name              basetypes.StringValue `tfsdk:"name"`
+	CloudProviderType basetypes.StringValue `tfsdk:"type"`
+	Uuid              basetypes.StringValue `tfsdk:"uuid"`
+	state             attr.ValueState
 }
 
-func (v DailyValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 2)
+func (v CloudProviderValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["backup_time"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["max_backups_to_retain"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["uuid"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 2)
+		vals := make(map[string]tftypes.Value, 3)
 
-		val, err = v.BackupTime.ToTerraformValue(ctx)
+		val, err = v.Name.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["backup_time"] = val
+		vals["name"] = val
 
-		val, err = v.MaxBackupsToRetain.ToTerraformValue(ctx)
+		val, err = v.CloudProviderType.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["max_backups_to_retain"] = val
+		vals["type"] = val
+
+		val, err = v.Uuid.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["uuid"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -4547,36 +5130,38 @@ func (v DailyValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 	}
 }
 
-func (v DailyValue) IsNull() bool {
+func (v CloudProviderValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v DailyValue) IsUnknown() bool {
+func (v CloudProviderValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v DailyValue) String() string {
-	return "DailyValue"
+func (v CloudProviderValue) String() string {
+	return "CloudProviderValue"
 }
 
-func (v DailyValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v CloudProviderValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"backup_time":           basetypes.StringType{},
-			"max_backups_to_retain": basetypes.Int64Type{},
+			"name": basetypes.StringType{},
+			"type": basetypes.StringType{},
+			"uuid": basetypes.StringType{},
 		},
 		map[string]attr.Value{
-			"backup_time":           v.BackupTime,
-			"max_backups_to_retain": v.MaxBackupsToRetain,
+			"name": v.Name,
+			"type": v.CloudProviderType,
+			"uuid": v.Uuid,
 		})
 
 	return objVal, diags
 }
 
-func (v DailyValue) Equal(o attr.Value) bool {
-	other, ok := o.(DailyValue)
+func (v CloudProviderValue) Equal(o attr.Value) bool {
+	other, ok := o.(CloudProviderValue)
 
 	if !ok {
 		return false
@@ -4590,40 +5175,45 @@ func (v DailyValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.BackupTime.Equal(other.BackupTime) {
+	if !v.Name.Equal(other.Name) {
 		return false
 	}
 
-	if !v.MaxBackupsToRetain.Equal(other.MaxBackupsToRetain) {
+	if !v.CloudProviderType.Equal(other.CloudProviderType) {
+		return false
+	}
+
+	if !v.Uuid.Equal(other.Uuid) {
 		return false
 	}
 
 	return true
 }
 
-func (v DailyValue) Type(ctx context.Context) attr.Type {
-	return DailyType{
+func (v CloudProviderValue) Type(ctx context.Context) attr.Type {
+	return CloudProviderType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v DailyValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v CloudProviderValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"backup_time":           basetypes.StringType{},
-		"max_backups_to_retain": basetypes.Int64Type{},
+		"name": basetypes.StringType{},
+		"type": basetypes.StringType{},
+		"uuid": basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = IntervalType{}
+var _ basetypes.ObjectTypable = CustomRegistryType{}
 
-type IntervalType struct {
+type CustomRegistryType struct {
 	basetypes.ObjectType
 }
 
-func (t IntervalType) Equal(o attr.Type) bool {
-	other, ok := o.(IntervalType)
+func (t CustomRegistryType) Equal(o attr.Type) bool {
+	other, ok := o.(CustomRegistryType)
 
 	if !ok {
 		return false
@@ -4632,174 +5222,364 @@ func (t IntervalType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t IntervalType) String() string {
-	return "IntervalType"
+func (t CustomRegistryType) String() string {
+	return "CustomRegistryType"
 }
 
-func (t IntervalType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t CustomRegistryType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	backupIntervalAttribute, ok := attributes["backup_interval"]
+	certPathAttribute, ok := attributes["cert_path"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`backup_interval is missing from object`)
+			`cert_path is missing from object`)
 
 		return nil, diags
 	}
 
-	backupIntervalVal, ok := backupIntervalAttribute.(basetypes.StringValue)
+	certPathVal, ok := certPathAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`backup_interval expected to be basetypes.StringValue, was: %T`, backupIntervalAttribute))
+			fmt.Sprintf(`cert_path expected to be basetypes.StringValue, was: %T`, certPathAttribute))
 	}
 
-	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+	passwordAttribute, ok := attributes["password"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`max_backups_to_retain is missing from object`)
+			`password is missing from object`)
 
 		return nil, diags
 	}
 
-	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+	passwordVal, ok := passwordAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
-	}
-
-	if diags.HasError() {
-		return nil, diags
+			fmt.Sprintf(`password expected to be basetypes.StringValue, was: %T`, passwordAttribute))
 	}
 
-	return IntervalValue{
-		BackupInterval:     backupIntervalVal,
-		MaxBackupsToRetain: maxBackupsToRetainVal,
-		state:              attr.ValueStateKnown,
-	}, diags
-}
+	repoPathAttribute, ok := attributes["repo_path"]
 
-func NewIntervalValueNull() IntervalValue {
-	return IntervalValue{
-		state: attr.ValueStateNull,
-	}
-}
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`repo_path is missing from object`)
 
-func NewIntervalValueUnknown() IntervalValue {
-	return IntervalValue{
-		state: attr.ValueStateUnknown,
+		return nil, diags
 	}
-}
 
-func NewIntervalValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (IntervalValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
-	ctx := context.Background()
+	repoPathVal, ok := repoPathAttribute.(basetypes.StringValue)
 
-	for name, attributeType := range attributeTypes {
-		attribute, ok := attributes[name]
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`repo_path expected to be basetypes.StringValue, was: %T`, repoPathAttribute))
+	}
 
-		if !ok {
-			diags.AddError(
-				"Missing IntervalValue Attribute Value",
-				"While creating a IntervalValue value, a missing attribute value was detected. "+
-					"A IntervalValue must contain values for all attributes, even if null or unknown. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("IntervalValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
-			)
+	selfSignedCertsAttribute, ok := attributes["self_signed_certs"]
 
-			continue
-		}
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`self_signed_certs is missing from object`)
 
-		if !attributeType.Equal(attribute.Type(ctx)) {
-			diags.AddError(
-				"Invalid IntervalValue Attribute Type",
-				"While creating a IntervalValue value, an invalid attribute value was detected. "+
-					"A IntervalValue must use a matching attribute type for the value. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("IntervalValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("IntervalValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
-			)
-		}
+		return nil, diags
 	}
 
-	for name := range attributes {
-		_, ok := attributeTypes[name]
-
-		if !ok {
-			diags.AddError(
-				"Extra IntervalValue Attribute Value",
-				"While creating a IntervalValue value, an extra attribute value was detected. "+
-					"A IntervalValue must not contain values beyond the expected attribute types. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra IntervalValue Attribute Name: %s", name),
-			)
-		}
-	}
+	selfSignedCertsVal, ok := selfSignedCertsAttribute.(basetypes.BoolValue)
 
-	if diags.HasError() {
-		return NewIntervalValueUnknown(), diags
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`self_signed_certs expected to be basetypes.BoolValue, was: %T`, selfSignedCertsAttribute))
 	}
 
-	backupIntervalAttribute, ok := attributes["backup_interval"]
+	skipTlsAttribute, ok := attributes["skip_tls"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`backup_interval is missing from object`)
+			`skip_tls is missing from object`)
 
-		return NewIntervalValueUnknown(), diags
+		return nil, diags
 	}
 
-	backupIntervalVal, ok := backupIntervalAttribute.(basetypes.StringValue)
+	skipTlsVal, ok := skipTlsAttribute.(basetypes.BoolValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`backup_interval expected to be basetypes.StringValue, was: %T`, backupIntervalAttribute))
+			fmt.Sprintf(`skip_tls expected to be basetypes.BoolValue, was: %T`, skipTlsAttribute))
 	}
 
-	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+	urlAttribute, ok := attributes["url"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`max_backups_to_retain is missing from object`)
+			`url is missing from object`)
 
-		return NewIntervalValueUnknown(), diags
+		return nil, diags
 	}
 
-	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+	urlVal, ok := urlAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
+			fmt.Sprintf(`url expected to be basetypes.StringValue, was: %T`, urlAttribute))
 	}
 
-	if diags.HasError() {
-		return NewIntervalValueUnknown(), diags
+	usernameAttribute, ok := attributes["username"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`username is missing from object`)
+
+		return nil, diags
 	}
 
-	return IntervalValue{
-		BackupInterval:     backupIntervalVal,
-		MaxBackupsToRetain: maxBackupsToRetainVal,
-		state:              attr.ValueStateKnown,
+	usernameVal, ok := usernameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`username expected to be basetypes.StringValue, was: %T`, usernameAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return CustomRegistryValue{
+		CertPath:        certPathVal,
+		Password:        passwordVal,
+		RepoPath:        repoPathVal,
+		SelfSignedCerts: selfSignedCertsVal,
+		SkipTls:         skipTlsVal,
+		Url:             urlVal,
+		Username:        usernameVal,
+		state:           attr.ValueStateKnown,
 	}, diags
 }
 
-func NewIntervalValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) IntervalValue {
-	object, diags := NewIntervalValue(attributeTypes, attributes)
+func NewCustomRegistryValueNull() CustomRegistryValue {
+	return CustomRegistryValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewCustomRegistryValueUnknown() CustomRegistryValue {
+	return CustomRegistryValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewCustomRegistryValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CustomRegistryValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing CustomRegistryValue Attribute Value",
+				"While creating a CustomRegistryValue value, a missing attribute value was detected. "+
+					"A CustomRegistryValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("CustomRegistryValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid CustomRegistryValue Attribute Type",
+				"While creating a CustomRegistryValue value, an invalid attribute value was detected. "+
+					"A CustomRegistryValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("CustomRegistryValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("CustomRegistryValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra CustomRegistryValue Attribute Value",
+				"While creating a CustomRegistryValue value, an extra attribute value was detected. "+
+					"A CustomRegistryValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra CustomRegistryValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	certPathAttribute, ok := attributes["cert_path"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cert_path is missing from object`)
+
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	certPathVal, ok := certPathAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cert_path expected to be basetypes.StringValue, was: %T`, certPathAttribute))
+	}
+
+	passwordAttribute, ok := attributes["password"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`password is missing from object`)
+
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	passwordVal, ok := passwordAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`password expected to be basetypes.StringValue, was: %T`, passwordAttribute))
+	}
+
+	repoPathAttribute, ok := attributes["repo_path"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`repo_path is missing from object`)
+
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	repoPathVal, ok := repoPathAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`repo_path expected to be basetypes.StringValue, was: %T`, repoPathAttribute))
+	}
+
+	selfSignedCertsAttribute, ok := attributes["self_signed_certs"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`self_signed_certs is missing from object`)
+
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	selfSignedCertsVal, ok := selfSignedCertsAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`self_signed_certs expected to be basetypes.BoolValue, was: %T`, selfSignedCertsAttribute))
+	}
+
+	skipTlsAttribute, ok := attributes["skip_tls"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`skip_tls is missing from object`)
+
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	skipTlsVal, ok := skipTlsAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`skip_tls expected to be basetypes.BoolValue, was: %T`, skipTlsAttribute))
+	}
+
+	urlAttribute, ok := attributes["url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`url is missing from object`)
+
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	urlVal, ok := urlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`url expected to be basetypes.StringValue, was: %T`, urlAttribute))
+	}
+
+	usernameAttribute, ok := attributes["username"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`username is missing from object`)
+
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	usernameVal, ok := usernameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`username expected to be basetypes.StringValue, was: %T`, usernameAttribute))
+	}
+
+	if diags.HasError() {
+		return NewCustomRegistryValueUnknown(), diags
+	}
+
+	return CustomRegistryValue{
+		CertPath:        certPathVal,
+		Password:        passwordVal,
+		RepoPath:        repoPathVal,
+		SelfSignedCerts: selfSignedCertsVal,
+		SkipTls:         skipTlsVal,
+		Url:             urlVal,
+		Username:        usernameVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewCustomRegistryValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CustomRegistryValue {
+	object, diags := NewCustomRegistryValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -4813,15 +5593,15 @@ func NewIntervalValueMust(attributeTypes map[string]attr.Type, attributes map[st
 				diagnostic.Detail()))
 		}
 
-		panic("NewIntervalValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewCustomRegistryValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t IntervalType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t CustomRegistryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewIntervalValueNull(), nil
+		return NewCustomRegistryValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -4829,11 +5609,11 @@ func (t IntervalType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 	}
 
 	if !in.IsKnown() {
-		return NewIntervalValueUnknown(), nil
+		return NewCustomRegistryValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewIntervalValueNull(), nil
+		return NewCustomRegistryValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -4856,51 +5636,101 @@ func (t IntervalType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 		attributes[k] = a
 	}
 
-	return NewIntervalValueMust(IntervalValue{}.AttributeTypes(ctx), attributes), nil
+	return NewCustomRegistryValueMust(CustomRegistryValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t IntervalType) ValueType(ctx context.Context) attr.Value {
-	return IntervalValue{}
+func (t CustomRegistryType) ValueType(ctx context.Context) attr.Value {
+	return CustomRegistryValue{}
 }
 
-var _ basetypes.ObjectValuable = IntervalValue{}
+var _ basetypes.ObjectValuable = CustomRegistryValue{}
 
-type IntervalValue struct {
-	BackupInterval     basetypes.StringValue `tfsdk:"backup_interval"`
-	MaxBackupsToRetain basetypes.Int64Value  `tfsdk:"max_backups_to_retain"`
-	state              attr.ValueState
+type CustomRegistryValue struct {
+	CertPath        basetypes.StringValue `tfsdk:"cert_path"`
+	Password        basetypes.StringValue `tfsdk:"password"`
+	RepoPath        basetypes.StringValue `tfsdk:"repo_path"`
+	SelfSignedCerts basetypes.BoolValue   `tfsdk:"self_signed_certs"`
+	SkipTls         basetypes.BoolValue   `tfsdk:"skip_tls"`
+	Url             basetypes.StringValue `tfsdk:"url"`
+	Username        basetypes.StringValue `tfsdk:"username"`
+	state           attr.ValueState
 }
 
-func (v IntervalValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 2)
+func (v CustomRegistryValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 7)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["backup_interval"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["max_backups_to_retain"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["cert_path"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["password"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["repo_path"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["self_signed_certs"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["skip_tls"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["url"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["username"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 2)
+		vals := make(map[string]tftypes.Value, 7)
 
-		val, err = v.BackupInterval.ToTerraformValue(ctx)
+		val, err = v.CertPath.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["backup_interval"] = val
+		vals["cert_path"] = val
 
-		val, err = v.MaxBackupsToRetain.ToTerraformValue(ctx)
+		val, err = v.Password.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["max_backups_to_retain"] = val
+		vals["password"] = val
+
+		val, err = v.RepoPath.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["repo_path"] = val
+
+		val, err = v.SelfSignedCerts.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["self_signed_certs"] = val
+
+		val, err = v.SkipTls.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["skip_tls"] = val
+
+		val, err = v.Url.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["url"] = val
+
+		val, err = v.Username.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["username"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -4916,36 +5746,46 @@ func (v IntervalValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 	}
 }
 
-func (v IntervalValue) IsNull() bool {
+func (v CustomRegistryValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v IntervalValue) IsUnknown() bool {
+func (v CustomRegistryValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v IntervalValue) String() string {
-	return "IntervalValue"
+func (v CustomRegistryValue) String() string {
+	return "CustomRegistryValue"
 }
 
-func (v IntervalValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v CustomRegistryValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"backup_interval":       basetypes.StringType{},
-			"max_backups_to_retain": basetypes.Int64Type{},
+			"cert_path":         basetypes.StringType{},
+			"password":          basetypes.StringType{},
+			"repo_path":         basetypes.StringType{},
+			"self_signed_certs": basetypes.BoolType{},
+			"skip_tls":          basetypes.BoolType{},
+			"url":               basetypes.StringType{},
+			"username":          basetypes.StringType{},
 		},
 		map[string]attr.Value{
-			"backup_interval":       v.BackupInterval,
-			"max_backups_to_retain": v.MaxBackupsToRetain,
+			"cert_path":         v.CertPath,
+			"password":          v.Password,
+			"repo_path":         v.RepoPath,
+			"self_signed_certs": v.SelfSignedCerts,
+			"skip_tls":          v.SkipTls,
+			"url":               v.Url,
+			"username":          v.Username,
 		})
 
 	return objVal, diags
 }
 
-func (v IntervalValue) Equal(o attr.Value) bool {
-	other, ok := o.(IntervalValue)
+func (v CustomRegistryValue) Equal(o attr.Value) bool {
+	other, ok := o.(CustomRegistryValue)
 
 	if !ok {
 		return false
@@ -4959,40 +5799,65 @@ func (v IntervalValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.BackupInterval.Equal(other.BackupInterval) {
+	if !v.CertPath.Equal(other.CertPath) {
 		return false
 	}
 
-	if !v.MaxBackupsToRetain.Equal(other.MaxBackupsToRetain) {
+	if !v.Password.Equal(other.Password) {
+		return false
+	}
+
+	if !v.RepoPath.Equal(other.RepoPath) {
+		return false
+	}
+
+	if !v.SelfSignedCerts.Equal(other.SelfSignedCerts) {
+		return false
+	}
+
+	if !v.SkipTls.Equal(other.SkipTls) {
+		return false
+	}
+
+	if !v.Url.Equal(other.Url) {
+		return false
+	}
+
+	if !v.Username.Equal(other.Username) {
 		return false
 	}
 
 	return true
 }
 
-func (v IntervalValue) Type(ctx context.Context) attr.Type {
-	return IntervalType{
+func (v CustomRegistryValue) Type(ctx context.Context) attr.Type {
+	return CustomRegistryType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v IntervalValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v CustomRegistryValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"backup_interval":       basetypes.StringType{},
-		"max_backups_to_retain": basetypes.Int64Type{},
+		"cert_path":         basetypes.StringType{},
+		"password":          basetypes.StringType{},
+		"repo_path":         basetypes.StringType{},
+		"self_signed_certs": basetypes.BoolType{},
+		"skip_tls":          basetypes.BoolType{},
+		"url":               basetypes.StringType{},
+		"username":          basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = K8sConfigType{}
+var _ basetypes.ObjectTypable = EtcdType{}
 
-type K8sConfigType struct {
+type EtcdType struct {
 	basetypes.ObjectType
 }
 
-func (t K8sConfigType) Equal(o attr.Type) bool {
-	other, ok := o.(K8sConfigType)
+func (t EtcdType) Equal(o attr.Type) bool {
+	other, ok := o.(EtcdType)
 
 	if !ok {
 		return false
@@ -5001,113 +5866,132 @@ func (t K8sConfigType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t K8sConfigType) String() string {
-	return "K8sConfigType"
+func (t EtcdType) String() string {
+	return "EtcdType"
 }
 
-func (t K8sConfigType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t EtcdType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	apiServerFlagsAttribute, ok := attributes["api_server_flags"]
+	dataDirAttribute, ok := attributes["data_dir"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`api_server_flags is missing from object`)
+			`data_dir is missing from object`)
 
 		return nil, diags
 	}
 
-	apiServerFlagsVal, ok := apiServerFlagsAttribute.(basetypes.ListValue)
+	dataDirVal, ok := dataDirAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`api_server_flags expected to be basetypes.ListValue, was: %T`, apiServerFlagsAttribute))
+			fmt.Sprintf(`data_dir expected to be basetypes.StringValue, was: %T`, dataDirAttribute))
 	}
 
-	apiServerRuntimeConfigAttribute, ok := attributes["api_server_runtime_config"]
+	electionTimeoutMsAttribute, ok := attributes["election_timeout_ms"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`api_server_runtime_config is missing from object`)
+			`election_timeout_ms is missing from object`)
 
 		return nil, diags
 	}
 
-	apiServerRuntimeConfigVal, ok := apiServerRuntimeConfigAttribute.(basetypes.StringValue)
+	electionTimeoutMsVal, ok := electionTimeoutMsAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`api_server_runtime_config expected to be basetypes.StringValue, was: %T`, apiServerRuntimeConfigAttribute))
+			fmt.Sprintf(`election_timeout_ms expected to be basetypes.Int64Value, was: %T`, electionTimeoutMsAttribute))
 	}
 
-	controllerManagerFlagsAttribute, ok := attributes["controller_manager_flags"]
+	enableEncryptionAttribute, ok := attributes["enable_encryption"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`controller_manager_flags is missing from object`)
+			`enable_encryption is missing from object`)
 
 		return nil, diags
 	}
 
-	controllerManagerFlagsVal, ok := controllerManagerFlagsAttribute.(basetypes.ListValue)
+	enableEncryptionVal, ok := enableEncryptionAttribute.(basetypes.BoolValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`controller_manager_flags expected to be basetypes.ListValue, was: %T`, controllerManagerFlagsAttribute))
+			fmt.Sprintf(`enable_encryption expected to be basetypes.BoolValue, was: %T`, enableEncryptionAttribute))
 	}
 
-	schedulerFlagsAttribute, ok := attributes["scheduler_flags"]
+	heartbeatIntervalMsAttribute, ok := attributes["heartbeat_interval_ms"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`scheduler_flags is missing from object`)
+			`heartbeat_interval_ms is missing from object`)
 
 		return nil, diags
 	}
 
-	schedulerFlagsVal, ok := schedulerFlagsAttribute.(basetypes.ListValue)
+	heartbeatIntervalMsVal, ok := heartbeatIntervalMsAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`scheduler_flags expected to be basetypes.ListValue, was: %T`, schedulerFlagsAttribute))
+			fmt.Sprintf(`heartbeat_interval_ms expected to be basetypes.Int64Value, was: %T`, heartbeatIntervalMsAttribute))
+	}
+
+	versionAttribute, ok := attributes["version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`version is missing from object`)
+
+		return nil, diags
+	}
+
+	versionVal, ok := versionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return K8sConfigValue{
-		ApiServerFlags:         apiServerFlagsVal,
-		ApiServerRuntimeConfig: apiServerRuntimeConfigVal,
-		ControllerManagerFlags: controllerManagerFlagsVal,
-		SchedulerFlags:         schedulerFlagsVal,
-		state:                  attr.ValueStateKnown,
+	return EtcdValue{
+		DataDir:             dataDirVal,
+		ElectionTimeoutMs:   electionTimeoutMsVal,
+		EnableEncryption:    enableEncryptionVal,
+		HeartbeatIntervalMs: heartbeatIntervalMsVal,
+		Version:             versionVal,
+		state:               attr.ValueStateKnown,
 	}, diags
 }
 
-func NewK8sConfigValueNull() K8sConfigValue {
-	return K8sConfigValue{
+func NewEtcdValueNull() EtcdValue {
+	return EtcdValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewK8sConfigValueUnknown() K8sConfigValue {
-	return K8sConfigValue{
+func NewEtcdValueUnknown() EtcdValue {
+	return EtcdValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewK8sConfigValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (K8sConfigValue, diag.Diagnostics) {
+func NewEtcdValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (EtcdValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -5118,11 +6002,11 @@ func NewK8sConfigValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !ok {
 			diags.AddError(
-				"Missing K8sConfigValue Attribute Value",
-				"While creating a K8sConfigValue value, a missing attribute value was detected. "+
-					"A K8sConfigValue must contain values for all attributes, even if null or unknown. "+
+				"Missing EtcdValue Attribute Value",
+				"While creating a EtcdValue value, a missing attribute value was detected. "+
+					"A EtcdValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("K8sConfigValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("EtcdValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -5130,13 +6014,13 @@ func NewK8sConfigValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid K8sConfigValue Attribute Type",
-				"While creating a K8sConfigValue value, an invalid attribute value was detected. "+
-					"A K8sConfigValue must use a matching attribute type for the value. "+
+				"Invalid EtcdValue Attribute Type",
+				"While creating a EtcdValue value, an invalid attribute value was detected. "+
+					"A EtcdValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("K8sConfigValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("K8sConfigValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
-			)
+					fmt.Sprintf("EtcdValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("EtcdValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
 		}
 	}
 
@@ -5145,106 +6029,125 @@ func NewK8sConfigValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !ok {
 			diags.AddError(
-				"Extra K8sConfigValue Attribute Value",
-				"While creating a K8sConfigValue value, an extra attribute value was detected. "+
-					"A K8sConfigValue must not contain values beyond the expected attribute types. "+
+				"Extra EtcdValue Attribute Value",
+				"While creating a EtcdValue value, an extra attribute value was detected. "+
+					"A EtcdValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra K8sConfigValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra EtcdValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewK8sConfigValueUnknown(), diags
+		return NewEtcdValueUnknown(), diags
 	}
 
-	apiServerFlagsAttribute, ok := attributes["api_server_flags"]
+	dataDirAttribute, ok := attributes["data_dir"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`api_server_flags is missing from object`)
+			`data_dir is missing from object`)
 
-		return NewK8sConfigValueUnknown(), diags
+		return NewEtcdValueUnknown(), diags
 	}
 
-	apiServerFlagsVal, ok := apiServerFlagsAttribute.(basetypes.ListValue)
+	dataDirVal, ok := dataDirAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`api_server_flags expected to be basetypes.ListValue, was: %T`, apiServerFlagsAttribute))
+			fmt.Sprintf(`data_dir expected to be basetypes.StringValue, was: %T`, dataDirAttribute))
 	}
 
-	apiServerRuntimeConfigAttribute, ok := attributes["api_server_runtime_config"]
+	electionTimeoutMsAttribute, ok := attributes["election_timeout_ms"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`api_server_runtime_config is missing from object`)
+			`election_timeout_ms is missing from object`)
 
-		return NewK8sConfigValueUnknown(), diags
+		return NewEtcdValueUnknown(), diags
 	}
 
-	apiServerRuntimeConfigVal, ok := apiServerRuntimeConfigAttribute.(basetypes.StringValue)
+	electionTimeoutMsVal, ok := electionTimeoutMsAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`api_server_runtime_config expected to be basetypes.StringValue, was: %T`, apiServerRuntimeConfigAttribute))
+			fmt.Sprintf(`election_timeout_ms expected to be basetypes.Int64Value, was: %T`, electionTimeoutMsAttribute))
 	}
 
-	controllerManagerFlagsAttribute, ok := attributes["controller_manager_flags"]
+	enableEncryptionAttribute, ok := attributes["enable_encryption"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`controller_manager_flags is missing from object`)
+			`enable_encryption is missing from object`)
 
-		return NewK8sConfigValueUnknown(), diags
+		return NewEtcdValueUnknown(), diags
 	}
 
-	controllerManagerFlagsVal, ok := controllerManagerFlagsAttribute.(basetypes.ListValue)
+	enableEncryptionVal, ok := enableEncryptionAttribute.(basetypes.BoolValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`controller_manager_flags expected to be basetypes.ListValue, was: %T`, controllerManagerFlagsAttribute))
+			fmt.Sprintf(`enable_encryption expected to be basetypes.BoolValue, was: %T`, enableEncryptionAttribute))
 	}
 
-	schedulerFlagsAttribute, ok := attributes["scheduler_flags"]
+	heartbeatIntervalMsAttribute, ok := attributes["heartbeat_interval_ms"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`scheduler_flags is missing from object`)
+			`heartbeat_interval_ms is missing from object`)
 
-		return NewK8sConfigValueUnknown(), diags
+		return NewEtcdValueUnknown(), diags
 	}
 
-	schedulerFlagsVal, ok := schedulerFlagsAttribute.(basetypes.ListValue)
+	heartbeatIntervalMsVal, ok := heartbeatIntervalMsAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`scheduler_flags expected to be basetypes.ListValue, was: %T`, schedulerFlagsAttribute))
+			fmt.Sprintf(`heartbeat_interval_ms expected to be basetypes.Int64Value, was: %T`, heartbeatIntervalMsAttribute))
+	}
+
+	versionAttribute, ok := attributes["version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`version is missing from object`)
+
+		return NewEtcdValueUnknown(), diags
+	}
+
+	versionVal, ok := versionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
 	}
 
 	if diags.HasError() {
-		return NewK8sConfigValueUnknown(), diags
+		return NewEtcdValueUnknown(), diags
 	}
 
-	return K8sConfigValue{
-		ApiServerFlags:         apiServerFlagsVal,
-		ApiServerRuntimeConfig: apiServerRuntimeConfigVal,
-		ControllerManagerFlags: controllerManagerFlagsVal,
-		SchedulerFlags:         schedulerFlagsVal,
-		state:                  attr.ValueStateKnown,
+	return EtcdValue{
+		DataDir:             dataDirVal,
+		ElectionTimeoutMs:   electionTimeoutMsVal,
+		EnableEncryption:    enableEncryptionVal,
+		HeartbeatIntervalMs: heartbeatIntervalMsVal,
+		Version:             versionVal,
+		state:               attr.ValueStateKnown,
 	}, diags
 }
 
-func NewK8sConfigValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) K8sConfigValue {
-	object, diags := NewK8sConfigValue(attributeTypes, attributes)
+func NewEtcdValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) EtcdValue {
+	object, diags := NewEtcdValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -5258,15 +6161,15 @@ func NewK8sConfigValueMust(attributeTypes map[string]attr.Type, attributes map[s
 				diagnostic.Detail()))
 		}
 
-		panic("NewK8sConfigValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewEtcdValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t K8sConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t EtcdType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewK8sConfigValueNull(), nil
+		return NewEtcdValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -5274,11 +6177,11 @@ func (t K8sConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 	}
 
 	if !in.IsKnown() {
-		return NewK8sConfigValueUnknown(), nil
+		return NewEtcdValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewK8sConfigValueNull(), nil
+		return NewEtcdValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -5301,77 +6204,81 @@ func (t K8sConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 		attributes[k] = a
 	}
 
-	return NewK8sConfigValueMust(K8sConfigValue{}.AttributeTypes(ctx), attributes), nil
+	return NewEtcdValueMust(EtcdValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t K8sConfigType) ValueType(ctx context.Context) attr.Value {
-	return K8sConfigValue{}
+func (t EtcdType) ValueType(ctx context.Context) attr.Value {
+	return EtcdValue{}
 }
 
-var _ basetypes.ObjectValuable = K8sConfigValue{}
+var _ basetypes.ObjectValuable = EtcdValue{}
 
-type K8sConfigValue struct {
-	ApiServerFlags         basetypes.ListValue   `tfsdk:"api_server_flags"`
-	ApiServerRuntimeConfig basetypes.StringValue `tfsdk:"api_server_runtime_config"`
-	ControllerManagerFlags basetypes.ListValue   `tfsdk:"controller_manager_flags"`
-	SchedulerFlags         basetypes.ListValue   `tfsdk:"scheduler_flags"`
-	state                  attr.ValueState
+type EtcdValue struct {
+	DataDir             basetypes.StringValue `tfsdk:"data_dir"`
+	ElectionTimeoutMs   basetypes.Int64Value  `tfsdk:"election_timeout_ms"`
+	EnableEncryption    basetypes.BoolValue   `tfsdk:"enable_encryption"`
+	HeartbeatIntervalMs basetypes.Int64Value  `tfsdk:"heartbeat_interval_ms"`
+	Version             basetypes.StringValue `tfsdk:"version"`
+	state               attr.ValueState
 }
 
-func (v K8sConfigValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 4)
+func (v EtcdValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 5)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["api_server_flags"] = basetypes.ListType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["api_server_runtime_config"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["controller_manager_flags"] = basetypes.ListType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["scheduler_flags"] = basetypes.ListType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
+	attrTypes["data_dir"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["election_timeout_ms"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["enable_encryption"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["heartbeat_interval_ms"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["version"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 4)
+		vals := make(map[string]tftypes.Value, 5)
 
-		val, err = v.ApiServerFlags.ToTerraformValue(ctx)
+		val, err = v.DataDir.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["api_server_flags"] = val
+		vals["data_dir"] = val
 
-		val, err = v.ApiServerRuntimeConfig.ToTerraformValue(ctx)
+		val, err = v.ElectionTimeoutMs.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["api_server_runtime_config"] = val
+		vals["election_timeout_ms"] = val
 
-		val, err = v.ControllerManagerFlags.ToTerraformValue(ctx)
+		val, err = v.EnableEncryption.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["controller_manager_flags"] = val
+		vals["enable_encryption"] = val
 
-		val, err = v.SchedulerFlags.ToTerraformValue(ctx)
+		val, err = v.HeartbeatIntervalMs.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["scheduler_flags"] = val
+		vals["heartbeat_interval_ms"] = val
+
+		val, err = v.Version.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["version"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -5387,166 +6294,104 @@ func (v K8sConfigValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 	}
 }
 
-func (v K8sConfigValue) IsNull() bool {
+func (v EtcdValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v K8sConfigValue) IsUnknown() bool {
+func (v EtcdValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v K8sConfigValue) String() string {
-	return "K8sConfigValue"
+func (v EtcdValue) String() string {
+	return "EtcdValue"
 }
 
-func (v K8sConfigValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v EtcdValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	apiServerFlagsVal, d := types.ListValue(types.StringType, v.ApiServerFlags.Elements())
-
-	diags.Append(d...)
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"data_dir":              basetypes.StringType{},
+			"election_timeout_ms":   basetypes.Int64Type{},
+			"enable_encryption":     basetypes.BoolType{},
+			"heartbeat_interval_ms": basetypes.Int64Type{},
+			"version":               basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"data_dir":              v.DataDir,
+			"election_timeout_ms":   v.ElectionTimeoutMs,
+			"enable_encryption":     v.EnableEncryption,
+			"heartbeat_interval_ms": v.HeartbeatIntervalMs,
+			"version":               v.Version,
+		})
 
-	if d.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"api_server_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"api_server_runtime_config": basetypes.StringType{},
-			"controller_manager_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"scheduler_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
+	return objVal, diags
+}
 
-	controllerManagerFlagsVal, d := types.ListValue(types.StringType, v.ControllerManagerFlags.Elements())
+func (v EtcdValue) Equal(o attr.Value) bool {
+	other, ok := o.(EtcdValue)
 
-	diags.Append(d...)
+	if !ok {
+		return false
+	}
 
-	if d.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"api_server_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"api_server_runtime_config": basetypes.StringType{},
-			"controller_manager_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"scheduler_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-		}), diags
+	if v.state != other.state {
+		return false
 	}
 
-	schedulerFlagsVal, d := types.ListValue(types.StringType, v.SchedulerFlags.Elements())
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
 
-	diags.Append(d...)
+	if !v.DataDir.Equal(other.DataDir) {
+		return false
+	}
 
-	if d.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"api_server_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"api_server_runtime_config": basetypes.StringType{},
-			"controller_manager_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"scheduler_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-		}), diags
+	if !v.ElectionTimeoutMs.Equal(other.ElectionTimeoutMs) {
+		return false
 	}
 
-	objVal, diags := types.ObjectValue(
-		map[string]attr.Type{
-			"api_server_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"api_server_runtime_config": basetypes.StringType{},
-			"controller_manager_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"scheduler_flags": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-		},
-		map[string]attr.Value{
-			"api_server_flags":          apiServerFlagsVal,
-			"api_server_runtime_config": v.ApiServerRuntimeConfig,
-			"controller_manager_flags":  controllerManagerFlagsVal,
-			"scheduler_flags":           schedulerFlagsVal,
-		})
-
-	return objVal, diags
-}
-
-func (v K8sConfigValue) Equal(o attr.Value) bool {
-	other, ok := o.(K8sConfigValue)
-
-	if !ok {
-		return false
-	}
-
-	if v.state != other.state {
-		return false
-	}
-
-	if v.state != attr.ValueStateKnown {
-		return true
-	}
-
-	if !v.ApiServerFlags.Equal(other.ApiServerFlags) {
-		return false
-	}
-
-	if !v.ApiServerRuntimeConfig.Equal(other.ApiServerRuntimeConfig) {
+	if !v.EnableEncryption.Equal(other.EnableEncryption) {
 		return false
 	}
 
-	if !v.ControllerManagerFlags.Equal(other.ControllerManagerFlags) {
+	if !v.HeartbeatIntervalMs.Equal(other.HeartbeatIntervalMs) {
 		return false
 	}
 
-	if !v.SchedulerFlags.Equal(other.SchedulerFlags) {
+	if !v.Version.Equal(other.Version) {
 		return false
 	}
 
 	return true
 }
 
-func (v K8sConfigValue) Type(ctx context.Context) attr.Type {
-	return K8sConfigType{
+func (v EtcdValue) Type(ctx context.Context) attr.Type {
+	return EtcdType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v K8sConfigValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v EtcdValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"api_server_flags": basetypes.ListType{
-			ElemType: types.StringType,
-		},
-		"api_server_runtime_config": basetypes.StringType{},
-		"controller_manager_flags": basetypes.ListType{
-			ElemType: types.StringType,
-		},
-		"scheduler_flags": basetypes.ListType{
-			ElemType: types.StringType,
-		},
+		"data_dir":              basetypes.StringType{},
+		"election_timeout_ms":   basetypes.Int64Type{},
+		"enable_encryption":     basetypes.BoolType{},
+		"heartbeat_interval_ms": basetypes.Int64Type{},
+		"version":               basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = StatusType{}
+var _ basetypes.ObjectTypable = EtcdBackupType{}
 
-type StatusType struct {
+type EtcdBackupType struct {
 	basetypes.ObjectType
 }
 
-func (t StatusType) Equal(o attr.Type) bool {
-	other, ok := o.(StatusType)
+func (t EtcdBackupType) Equal(o attr.Type) bool {
+	other, ok := o.(EtcdBackupType)
 
 	if !ok {
 		return false
@@ -5555,170 +6400,113 @@ func (t StatusType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t StatusType) String() string {
-	return "StatusType"
+func (t EtcdBackupType) String() string {
+	return "EtcdBackupType"
 }
 
-func (t StatusType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t EtcdBackupType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	lastOkAttribute, ok := attributes["last_ok"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`last_ok is missing from object`)
-
-		return nil, diags
-	}
-
-	lastOkVal, ok := lastOkAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`last_ok expected to be basetypes.StringValue, was: %T`, lastOkAttribute))
-	}
-
-	lastOpAttribute, ok := attributes["last_op"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`last_op is missing from object`)
-
-		return nil, diags
-	}
-
-	lastOpVal, ok := lastOpAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`last_op expected to be basetypes.StringValue, was: %T`, lastOpAttribute))
-	}
-
-	masterStatusAttribute, ok := attributes["master_status"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`master_status is missing from object`)
-
-		return nil, diags
-	}
-
-	masterStatusVal, ok := masterStatusAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`master_status expected to be basetypes.StringValue, was: %T`, masterStatusAttribute))
-	}
-
-	statusAttribute, ok := attributes["status"]
+	dailyAttribute, ok := attributes["daily"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`status is missing from object`)
+			`daily is missing from object`)
 
 		return nil, diags
 	}
 
-	statusVal, ok := statusAttribute.(basetypes.StringValue)
+	dailyVal, ok := dailyAttribute.(basetypes.ObjectValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
+			fmt.Sprintf(`daily expected to be basetypes.ObjectValue, was: %T`, dailyAttribute))
 	}
 
-	taskErrorAttribute, ok := attributes["task_error"]
+	intervalAttribute, ok := attributes["interval"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`task_error is missing from object`)
+			`interval is missing from object`)
 
 		return nil, diags
 	}
 
-	taskErrorVal, ok := taskErrorAttribute.(basetypes.StringValue)
+	intervalVal, ok := intervalAttribute.(basetypes.ObjectValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`task_error expected to be basetypes.StringValue, was: %T`, taskErrorAttribute))
+			fmt.Sprintf(`interval expected to be basetypes.ObjectValue, was: %T`, intervalAttribute))
 	}
 
-	taskStatusAttribute, ok := attributes["task_status"]
+	storageLocalPathAttribute, ok := attributes["storage_local_path"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`task_status is missing from object`)
+			`storage_local_path is missing from object`)
 
 		return nil, diags
 	}
 
-	taskStatusVal, ok := taskStatusAttribute.(basetypes.StringValue)
+	storageLocalPathVal, ok := storageLocalPathAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`task_status expected to be basetypes.StringValue, was: %T`, taskStatusAttribute))
+			fmt.Sprintf(`storage_local_path expected to be basetypes.StringValue, was: %T`, storageLocalPathAttribute))
 	}
 
-	workerStatusAttribute, ok := attributes["worker_status"]
+	storageTypeAttribute, ok := attributes["storage_type"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`worker_status is missing from object`)
+			`storage_type is missing from object`)
 
 		return nil, diags
 	}
 
-	workerStatusVal, ok := workerStatusAttribute.(basetypes.StringValue)
+	storageTypeVal, ok := storageTypeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`worker_status expected to be basetypes.StringValue, was: %T`, workerStatusAttribute))
+			fmt.Sprintf(`storage_type expected to be basetypes.StringValue, was: %T`, storageTypeAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return StatusValue{
-		LastOk:       lastOkVal,
-		LastOp:       lastOpVal,
-		MasterStatus: masterStatusVal,
-		Status:       statusVal,
-		TaskError:    taskErrorVal,
-		TaskStatus:   taskStatusVal,
-		WorkerStatus: workerStatusVal,
-		state:        attr.ValueStateKnown,
+	return EtcdBackupValue{
+		Daily:            dailyVal,
+		Interval:         intervalVal,
+		StorageLocalPath: storageLocalPathVal,
+		StorageType:      storageTypeVal,
+		state:            attr.ValueStateKnown,
 	}, diags
 }
 
-func NewStatusValueNull() StatusValue {
-	return StatusValue{
+func NewEtcdBackupValueNull() EtcdBackupValue {
+	return EtcdBackupValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewStatusValueUnknown() StatusValue {
-	return StatusValue{
+func NewEtcdBackupValueUnknown() EtcdBackupValue {
+	return EtcdBackupValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewStatusValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (StatusValue, diag.Diagnostics) {
+func NewEtcdBackupValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (EtcdBackupValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -5729,11 +6517,11 @@ func NewStatusValue(attributeTypes map[string]attr.Type, attributes map[string]a
 
 		if !ok {
 			diags.AddError(
-				"Missing StatusValue Attribute Value",
-				"While creating a StatusValue value, a missing attribute value was detected. "+
-					"A StatusValue must contain values for all attributes, even if null or unknown. "+
+				"Missing EtcdBackupValue Attribute Value",
+				"While creating a EtcdBackupValue value, a missing attribute value was detected. "+
+					"A EtcdBackupValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("StatusValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("EtcdBackupValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -5741,12 +6529,12 @@ func NewStatusValue(attributeTypes map[string]attr.Type, attributes map[string]a
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid StatusValue Attribute Type",
-				"While creating a StatusValue value, an invalid attribute value was detected. "+
-					"A StatusValue must use a matching attribute type for the value. "+
+				"Invalid EtcdBackupValue Attribute Type",
+				"While creating a EtcdBackupValue value, an invalid attribute value was detected. "+
+					"A EtcdBackupValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("StatusValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("StatusValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("EtcdBackupValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("EtcdBackupValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -5756,163 +6544,2499 @@ func NewStatusValue(attributeTypes map[string]attr.Type, attributes map[string]a
 
 		if !ok {
 			diags.AddError(
-				"Extra StatusValue Attribute Value",
-				"While creating a StatusValue value, an extra attribute value was detected. "+
-					"A StatusValue must not contain values beyond the expected attribute types. "+
+				"Extra EtcdBackupValue Attribute Value",
+				"While creating a EtcdBackupValue value, an extra attribute value was detected. "+
+					"A EtcdBackupValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra StatusValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra EtcdBackupValue Attribute Name: %s", name),
 			)
 		}
 	}
 
-	if diags.HasError() {
-		return NewStatusValueUnknown(), diags
-	}
+	if diags.HasError() {
+		return NewEtcdBackupValueUnknown(), diags
+	}
+
+	dailyAttribute, ok := attributes["daily"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`daily is missing from object`)
+
+		return NewEtcdBackupValueUnknown(), diags
+	}
+
+	dailyVal, ok := dailyAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`daily expected to be basetypes.ObjectValue, was: %T`, dailyAttribute))
+	}
+
+	intervalAttribute, ok := attributes["interval"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`interval is missing from object`)
+
+		return NewEtcdBackupValueUnknown(), diags
+	}
+
+	intervalVal, ok := intervalAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`interval expected to be basetypes.ObjectValue, was: %T`, intervalAttribute))
+	}
+
+	storageLocalPathAttribute, ok := attributes["storage_local_path"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`storage_local_path is missing from object`)
+
+		return NewEtcdBackupValueUnknown(), diags
+	}
+
+	storageLocalPathVal, ok := storageLocalPathAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`storage_local_path expected to be basetypes.StringValue, was: %T`, storageLocalPathAttribute))
+	}
+
+	storageTypeAttribute, ok := attributes["storage_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`storage_type is missing from object`)
+
+		return NewEtcdBackupValueUnknown(), diags
+	}
+
+	storageTypeVal, ok := storageTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`storage_type expected to be basetypes.StringValue, was: %T`, storageTypeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewEtcdBackupValueUnknown(), diags
+	}
+
+	return EtcdBackupValue{
+		Daily:            dailyVal,
+		Interval:         intervalVal,
+		StorageLocalPath: storageLocalPathVal,
+		StorageType:      storageTypeVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewEtcdBackupValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) EtcdBackupValue {
+	object, diags := NewEtcdBackupValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewEtcdBackupValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t EtcdBackupType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewEtcdBackupValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewEtcdBackupValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewEtcdBackupValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewEtcdBackupValueMust(EtcdBackupValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t EtcdBackupType) ValueType(ctx context.Context) attr.Value {
+	return EtcdBackupValue{}
+}
+
+var _ basetypes.ObjectValuable = EtcdBackupValue{}
+
+type EtcdBackupValue struct {
+	Daily            basetypes.ObjectValue `tfsdk:"daily"`
+	Interval         basetypes.ObjectValue `tfsdk:"interval"`
+	StorageLocalPath basetypes.StringValue `tfsdk:"storage_local_path"`
+	StorageType      basetypes.StringValue `tfsdk:"storage_type"`
+	state            attr.ValueState
+}
+
+func (v EtcdBackupValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["daily"] = basetypes.ObjectType{
+		AttrTypes: DailyValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["interval"] = basetypes.ObjectType{
+		AttrTypes: IntervalValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["storage_local_path"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["storage_type"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.Daily.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["daily"] = val
+
+		val, err = v.Interval.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["interval"] = val
+
+		val, err = v.StorageLocalPath.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["storage_local_path"] = val
+
+		val, err = v.StorageType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["storage_type"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v EtcdBackupValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v EtcdBackupValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v EtcdBackupValue) String() string {
+	return "EtcdBackupValue"
+}
+
+func (v EtcdBackupValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var daily basetypes.ObjectValue
+
+	if v.Daily.IsNull() {
+		daily = types.ObjectNull(
+			DailyValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Daily.IsUnknown() {
+		daily = types.ObjectUnknown(
+			DailyValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Daily.IsNull() && !v.Daily.IsUnknown() {
+		daily = types.ObjectValueMust(
+			DailyValue{}.AttributeTypes(ctx),
+			v.Daily.Attributes(),
+		)
+	}
+
+	var interval basetypes.ObjectValue
+
+	if v.Interval.IsNull() {
+		interval = types.ObjectNull(
+			IntervalValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Interval.IsUnknown() {
+		interval = types.ObjectUnknown(
+			IntervalValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Interval.IsNull() && !v.Interval.IsUnknown() {
+		interval = types.ObjectValueMust(
+			IntervalValue{}.AttributeTypes(ctx),
+			v.Interval.Attributes(),
+		)
+	}
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"daily": basetypes.ObjectType{
+				AttrTypes: DailyValue{}.AttributeTypes(ctx),
+			},
+			"interval": basetypes.ObjectType{
+				AttrTypes: IntervalValue{}.AttributeTypes(ctx),
+			},
+			"storage_local_path": basetypes.StringType{},
+			"storage_type":       basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"daily":              daily,
+			"interval":           interval,
+			"storage_local_path": v.StorageLocalPath,
+			"storage_type":       v.StorageType,
+		})
+
+	return objVal, diags
+}
+
+func (v EtcdBackupValue) Equal(o attr.Value) bool {
+	other, ok := o.(EtcdBackupValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Daily.Equal(other.Daily) {
+		return false
+	}
+
+	if !v.Interval.Equal(other.Interval) {
+		return false
+	}
+
+	if !v.StorageLocalPath.Equal(other.StorageLocalPath) {
+		return false
+	}
+
+	if !v.StorageType.Equal(other.StorageType) {
+		return false
+	}
+
+	return true
+}
+
+func (v EtcdBackupValue) Type(ctx context.Context) attr.Type {
+	return EtcdBackupType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v EtcdBackupValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"daily": basetypes.ObjectType{
+			AttrTypes: DailyValue{}.AttributeTypes(ctx),
+		},
+		"interval": basetypes.ObjectType{
+			AttrTypes: IntervalValue{}.AttributeTypes(ctx),
+		},
+		"storage_local_path": basetypes.StringType{},
+		"storage_type":       basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = DailyType{}
+
+type DailyType struct {
+	basetypes.ObjectType
+}
+
+func (t DailyType) Equal(o attr.Type) bool {
+	other, ok := o.(DailyType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t DailyType) String() string {
+	return "DailyType"
+}
+
+func (t DailyType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	backupTimeAttribute, ok := attributes["backup_time"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`backup_time is missing from object`)
+
+		return nil, diags
+	}
+
+	backupTimeVal, ok := backupTimeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`backup_time expected to be basetypes.StringValue, was: %T`, backupTimeAttribute))
+	}
+
+	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_backups_to_retain is missing from object`)
+
+		return nil, diags
+	}
+
+	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return DailyValue{
+		BackupTime:         backupTimeVal,
+		MaxBackupsToRetain: maxBackupsToRetainVal,
+		state:              attr.ValueStateKnown,
+	}, diags
+}
+
+func NewDailyValueNull() DailyValue {
+	return DailyValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewDailyValueUnknown() DailyValue {
+	return DailyValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewDailyValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (DailyValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing DailyValue Attribute Value",
+				"While creating a DailyValue value, a missing attribute value was detected. "+
+					"A DailyValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DailyValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid DailyValue Attribute Type",
+				"While creating a DailyValue value, an invalid attribute value was detected. "+
+					"A DailyValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DailyValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("DailyValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra DailyValue Attribute Value",
+				"While creating a DailyValue value, an extra attribute value was detected. "+
+					"A DailyValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra DailyValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewDailyValueUnknown(), diags
+	}
+
+	backupTimeAttribute, ok := attributes["backup_time"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`backup_time is missing from object`)
+
+		return NewDailyValueUnknown(), diags
+	}
+
+	backupTimeVal, ok := backupTimeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`backup_time expected to be basetypes.StringValue, was: %T`, backupTimeAttribute))
+	}
+
+	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_backups_to_retain is missing from object`)
+
+		return NewDailyValueUnknown(), diags
+	}
+
+	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
+	}
+
+	if diags.HasError() {
+		return NewDailyValueUnknown(), diags
+	}
+
+	return DailyValue{
+		BackupTime:         backupTimeVal,
+		MaxBackupsToRetain: maxBackupsToRetainVal,
+		state:              attr.ValueStateKnown,
+	}, diags
+}
+
+func NewDailyValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) DailyValue {
+	object, diags := NewDailyValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewDailyValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t DailyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewDailyValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewDailyValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewDailyValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewDailyValueMust(DailyValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t DailyType) ValueType(ctx context.Context) attr.Value {
+	return DailyValue{}
+}
+
+var _ basetypes.ObjectValuable = DailyValue{}
+
+type DailyValue struct {
+	BackupTime         basetypes.StringValue `tfsdk:"backup_time"`
+	MaxBackupsToRetain basetypes.Int64Value  `tfsdk:"max_backups_to_retain"`
+	state              attr.ValueState
+}
+
+func (v DailyValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["backup_time"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["max_backups_to_retain"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.BackupTime.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["backup_time"] = val
+
+		val, err = v.MaxBackupsToRetain.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["max_backups_to_retain"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v DailyValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v DailyValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v DailyValue) String() string {
+	return "DailyValue"
+}
+
+func (v DailyValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"backup_time":           basetypes.StringType{},
+			"max_backups_to_retain": basetypes.Int64Type{},
+		},
+		map[string]attr.Value{
+			"backup_time":           v.BackupTime,
+			"max_backups_to_retain": v.MaxBackupsToRetain,
+		})
+
+	return objVal, diags
+}
+
+func (v DailyValue) Equal(o attr.Value) bool {
+	other, ok := o.(DailyValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.BackupTime.Equal(other.BackupTime) {
+		return false
+	}
+
+	if !v.MaxBackupsToRetain.Equal(other.MaxBackupsToRetain) {
+		return false
+	}
+
+	return true
+}
+
+func (v DailyValue) Type(ctx context.Context) attr.Type {
+	return DailyType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v DailyValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"backup_time":           basetypes.StringType{},
+		"max_backups_to_retain": basetypes.Int64Type{},
+	}
+}
+
+var _ basetypes.ObjectTypable = IntervalType{}
+
+type IntervalType struct {
+	basetypes.ObjectType
+}
+
+func (t IntervalType) Equal(o attr.Type) bool {
+	other, ok := o.(IntervalType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t IntervalType) String() string {
+	return "IntervalType"
+}
+
+func (t IntervalType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	backupIntervalAttribute, ok := attributes["backup_interval"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`backup_interval is missing from object`)
+
+		return nil, diags
+	}
+
+	backupIntervalVal, ok := backupIntervalAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`backup_interval expected to be basetypes.StringValue, was: %T`, backupIntervalAttribute))
+	}
+
+	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_backups_to_retain is missing from object`)
+
+		return nil, diags
+	}
+
+	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return IntervalValue{
+		BackupInterval:     backupIntervalVal,
+		MaxBackupsToRetain: maxBackupsToRetainVal,
+		state:              attr.ValueStateKnown,
+	}, diags
+}
+
+func NewIntervalValueNull() IntervalValue {
+	return IntervalValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewIntervalValueUnknown() IntervalValue {
+	return IntervalValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewIntervalValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (IntervalValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing IntervalValue Attribute Value",
+				"While creating a IntervalValue value, a missing attribute value was detected. "+
+					"A IntervalValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("IntervalValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid IntervalValue Attribute Type",
+				"While creating a IntervalValue value, an invalid attribute value was detected. "+
+					"A IntervalValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("IntervalValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("IntervalValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra IntervalValue Attribute Value",
+				"While creating a IntervalValue value, an extra attribute value was detected. "+
+					"A IntervalValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra IntervalValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewIntervalValueUnknown(), diags
+	}
+
+	backupIntervalAttribute, ok := attributes["backup_interval"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`backup_interval is missing from object`)
+
+		return NewIntervalValueUnknown(), diags
+	}
+
+	backupIntervalVal, ok := backupIntervalAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`backup_interval expected to be basetypes.StringValue, was: %T`, backupIntervalAttribute))
+	}
+
+	maxBackupsToRetainAttribute, ok := attributes["max_backups_to_retain"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_backups_to_retain is missing from object`)
+
+		return NewIntervalValueUnknown(), diags
+	}
+
+	maxBackupsToRetainVal, ok := maxBackupsToRetainAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_backups_to_retain expected to be basetypes.Int64Value, was: %T`, maxBackupsToRetainAttribute))
+	}
+
+	if diags.HasError() {
+		return NewIntervalValueUnknown(), diags
+	}
+
+	return IntervalValue{
+		BackupInterval:     backupIntervalVal,
+		MaxBackupsToRetain: maxBackupsToRetainVal,
+		state:              attr.ValueStateKnown,
+	}, diags
+}
+
+func NewIntervalValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) IntervalValue {
+	object, diags := NewIntervalValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewIntervalValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t IntervalType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewIntervalValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewIntervalValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewIntervalValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewIntervalValueMust(IntervalValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t IntervalType) ValueType(ctx context.Context) attr.Value {
+	return IntervalValue{}
+}
+
+var _ basetypes.ObjectValuable = IntervalValue{}
+
+type IntervalValue struct {
+	BackupInterval     basetypes.StringValue `tfsdk:"backup_interval"`
+	MaxBackupsToRetain basetypes.Int64Value  `tfsdk:"max_backups_to_retain"`
+	state              attr.ValueState
+}
+
+func (v IntervalValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["backup_interval"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["max_backups_to_retain"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.BackupInterval.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["backup_interval"] = val
+
+		val, err = v.MaxBackupsToRetain.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["max_backups_to_retain"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v IntervalValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v IntervalValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v IntervalValue) String() string {
+	return "IntervalValue"
+}
+
+func (v IntervalValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"backup_interval":       basetypes.StringType{},
+			"max_backups_to_retain": basetypes.Int64Type{},
+		},
+		map[string]attr.Value{
+			"backup_interval":       v.BackupInterval,
+			"max_backups_to_retain": v.MaxBackupsToRetain,
+		})
+
+	return objVal, diags
+}
+
+func (v IntervalValue) Equal(o attr.Value) bool {
+	other, ok := o.(IntervalValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.BackupInterval.Equal(other.BackupInterval) {
+		return false
+	}
+
+	if !v.MaxBackupsToRetain.Equal(other.MaxBackupsToRetain) {
+		return false
+	}
+
+	return true
+}
+
+func (v IntervalValue) Type(ctx context.Context) attr.Type {
+	return IntervalType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v IntervalValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"backup_interval":       basetypes.StringType{},
+		"max_backups_to_retain": basetypes.Int64Type{},
+	}
+}
+
+var _ basetypes.ObjectTypable = K8sConfigType{}
+
+type K8sConfigType struct {
+	basetypes.ObjectType
+}
+
+func (t K8sConfigType) Equal(o attr.Type) bool {
+	other, ok := o.(K8sConfigType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t K8sConfigType) String() string {
+	return "K8sConfigType"
+}
+
+func (t K8sConfigType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	apiServerFlagsAttribute, ok := attributes["api_server_flags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`api_server_flags is missing from object`)
+
+		return nil, diags
+	}
+
+	apiServerFlagsVal, ok := apiServerFlagsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`api_server_flags expected to be basetypes.ListValue, was: %T`, apiServerFlagsAttribute))
+	}
+
+	apiServerRuntimeConfigAttribute, ok := attributes["api_server_runtime_config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`api_server_runtime_config is missing from object`)
+
+		return nil, diags
+	}
+
+	apiServerRuntimeConfigVal, ok := apiServerRuntimeConfigAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`api_server_runtime_config expected to be basetypes.StringValue, was: %T`, apiServerRuntimeConfigAttribute))
+	}
+
+	controllerManagerFlagsAttribute, ok := attributes["controller_manager_flags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`controller_manager_flags is missing from object`)
+
+		return nil, diags
+	}
+
+	controllerManagerFlagsVal, ok := controllerManagerFlagsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`controller_manager_flags expected to be basetypes.ListValue, was: %T`, controllerManagerFlagsAttribute))
+	}
+
+	schedulerFlagsAttribute, ok := attributes["scheduler_flags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`scheduler_flags is missing from object`)
+
+		return nil, diags
+	}
+
+	schedulerFlagsVal, ok := schedulerFlagsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`scheduler_flags expected to be basetypes.ListValue, was: %T`, schedulerFlagsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return K8sConfigValue{
+		ApiServerFlags:         apiServerFlagsVal,
+		ApiServerRuntimeConfig: apiServerRuntimeConfigVal,
+		ControllerManagerFlags: controllerManagerFlagsVal,
+		SchedulerFlags:         schedulerFlagsVal,
+		state:                  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewK8sConfigValueNull() K8sConfigValue {
+	return K8sConfigValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewK8sConfigValueUnknown() K8sConfigValue {
+	return K8sConfigValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewK8sConfigValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (K8sConfigValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing K8sConfigValue Attribute Value",
+				"While creating a K8sConfigValue value, a missing attribute value was detected. "+
+					"A K8sConfigValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("K8sConfigValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid K8sConfigValue Attribute Type",
+				"While creating a K8sConfigValue value, an invalid attribute value was detected. "+
+					"A K8sConfigValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("K8sConfigValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("K8sConfigValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra K8sConfigValue Attribute Value",
+				"While creating a K8sConfigValue value, an extra attribute value was detected. "+
+					"A K8sConfigValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra K8sConfigValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewK8sConfigValueUnknown(), diags
+	}
+
+	apiServerFlagsAttribute, ok := attributes["api_server_flags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`api_server_flags is missing from object`)
+
+		return NewK8sConfigValueUnknown(), diags
+	}
+
+	apiServerFlagsVal, ok := apiServerFlagsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`api_server_flags expected to be basetypes.ListValue, was: %T`, apiServerFlagsAttribute))
+	}
+
+	apiServerRuntimeConfigAttribute, ok := attributes["api_server_runtime_config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`api_server_runtime_config is missing from object`)
+
+		return NewK8sConfigValueUnknown(), diags
+	}
+
+	apiServerRuntimeConfigVal, ok := apiServerRuntimeConfigAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`api_server_runtime_config expected to be basetypes.StringValue, was: %T`, apiServerRuntimeConfigAttribute))
+	}
+
+	controllerManagerFlagsAttribute, ok := attributes["controller_manager_flags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`controller_manager_flags is missing from object`)
+
+		return NewK8sConfigValueUnknown(), diags
+	}
+
+	controllerManagerFlagsVal, ok := controllerManagerFlagsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`controller_manager_flags expected to be basetypes.ListValue, was: %T`, controllerManagerFlagsAttribute))
+	}
+
+	schedulerFlagsAttribute, ok := attributes["scheduler_flags"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`scheduler_flags is missing from object`)
+
+		return NewK8sConfigValueUnknown(), diags
+	}
+
+	schedulerFlagsVal, ok := schedulerFlagsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`scheduler_flags expected to be basetypes.ListValue, was: %T`, schedulerFlagsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewK8sConfigValueUnknown(), diags
+	}
+
+	return K8sConfigValue{
+		ApiServerFlags:         apiServerFlagsVal,
+		ApiServerRuntimeConfig: apiServerRuntimeConfigVal,
+		ControllerManagerFlags: controllerManagerFlagsVal,
+		SchedulerFlags:         schedulerFlagsVal,
+		state:                  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewK8sConfigValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) K8sConfigValue {
+	object, diags := NewK8sConfigValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewK8sConfigValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t K8sConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewK8sConfigValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewK8sConfigValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewK8sConfigValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewK8sConfigValueMust(K8sConfigValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t K8sConfigType) ValueType(ctx context.Context) attr.Value {
+	return K8sConfigValue{}
+}
+
+var _ basetypes.ObjectValuable = K8sConfigValue{}
+
+type K8sConfigValue struct {
+	ApiServerFlags         basetypes.ListValue   `tfsdk:"api_server_flags"`
+	ApiServerRuntimeConfig basetypes.StringValue `tfsdk:"api_server_runtime_config"`
+	ControllerManagerFlags basetypes.ListValue   `tfsdk:"controller_manager_flags"`
+	SchedulerFlags         basetypes.ListValue   `tfsdk:"scheduler_flags"`
+	state                  attr.ValueState
+}
+
+func (v K8sConfigValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["api_server_flags"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["api_server_runtime_config"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["controller_manager_flags"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["scheduler_flags"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.ApiServerFlags.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["api_server_flags"] = val
+
+		val, err = v.ApiServerRuntimeConfig.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["api_server_runtime_config"] = val
+
+		val, err = v.ControllerManagerFlags.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["controller_manager_flags"] = val
+
+		val, err = v.SchedulerFlags.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["scheduler_flags"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v K8sConfigValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v K8sConfigValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v K8sConfigValue) String() string {
+	return "K8sConfigValue"
+}
+
+func (v K8sConfigValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiServerFlagsVal, d := types.ListValue(types.StringType, v.ApiServerFlags.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"api_server_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"api_server_runtime_config": basetypes.StringType{},
+			"controller_manager_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"scheduler_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	controllerManagerFlagsVal, d := types.ListValue(types.StringType, v.ControllerManagerFlags.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"api_server_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"api_server_runtime_config": basetypes.StringType{},
+			"controller_manager_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"scheduler_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	schedulerFlagsVal, d := types.ListValue(types.StringType, v.SchedulerFlags.Elements())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"api_server_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"api_server_runtime_config": basetypes.StringType{},
+			"controller_manager_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"scheduler_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"api_server_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"api_server_runtime_config": basetypes.StringType{},
+			"controller_manager_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"scheduler_flags": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		},
+		map[string]attr.Value{
+			"api_server_flags":          apiServerFlagsVal,
+			"api_server_runtime_config": v.ApiServerRuntimeConfig,
+			"controller_manager_flags":  controllerManagerFlagsVal,
+			"scheduler_flags":           schedulerFlagsVal,
+		})
+
+	return objVal, diags
+}
+
+func (v K8sConfigValue) Equal(o attr.Value) bool {
+	other, ok := o.(K8sConfigValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.ApiServerFlags.Equal(other.ApiServerFlags) {
+		return false
+	}
+
+	if !v.ApiServerRuntimeConfig.Equal(other.ApiServerRuntimeConfig) {
+		return false
+	}
+
+	if !v.ControllerManagerFlags.Equal(other.ControllerManagerFlags) {
+		return false
+	}
+
+	if !v.SchedulerFlags.Equal(other.SchedulerFlags) {
+		return false
+	}
+
+	return true
+}
+
+func (v K8sConfigValue) Type(ctx context.Context) attr.Type {
+	return K8sConfigType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v K8sConfigValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"api_server_flags": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"api_server_runtime_config": basetypes.StringType{},
+		"controller_manager_flags": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"scheduler_flags": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = StatusType{}
+
+type StatusType struct {
+	basetypes.ObjectType
+}
+
+func (t StatusType) Equal(o attr.Type) bool {
+	other, ok := o.(StatusType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t StatusType) String() string {
+	return "StatusType"
+}
+
+func (t StatusType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	lastOkAttribute, ok := attributes["last_ok"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_ok is missing from object`)
+
+		return nil, diags
+	}
+
+	lastOkVal, ok := lastOkAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_ok expected to be basetypes.StringValue, was: %T`, lastOkAttribute))
+	}
+
+	lastOpAttribute, ok := attributes["last_op"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_op is missing from object`)
+
+		return nil, diags
+	}
+
+	lastOpVal, ok := lastOpAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_op expected to be basetypes.StringValue, was: %T`, lastOpAttribute))
+	}
+
+	masterStatusAttribute, ok := attributes["master_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`master_status is missing from object`)
+
+		return nil, diags
+	}
+
+	masterStatusVal, ok := masterStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`master_status expected to be basetypes.StringValue, was: %T`, masterStatusAttribute))
+	}
+
+	statusAttribute, ok := attributes["status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`status is missing from object`)
+
+		return nil, diags
+	}
+
+	statusVal, ok := statusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
+	}
+
+	taskErrorAttribute, ok := attributes["task_error"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`task_error is missing from object`)
+
+		return nil, diags
+	}
+
+	taskErrorVal, ok := taskErrorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`task_error expected to be basetypes.StringValue, was: %T`, taskErrorAttribute))
+	}
+
+	taskStatusAttribute, ok := attributes["task_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`task_status is missing from object`)
+
+		return nil, diags
+	}
+
+	taskStatusVal, ok := taskStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`task_status expected to be basetypes.StringValue, was: %T`, taskStatusAttribute))
+	}
+
+	workerStatusAttribute, ok := attributes["worker_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`worker_status is missing from object`)
+
+		return nil, diags
+	}
+
+	workerStatusVal, ok := workerStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`worker_status expected to be basetypes.StringValue, was: %T`, workerStatusAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return StatusValue{
+		LastOk:       lastOkVal,
+		LastOp:       lastOpVal,
+		MasterStatus: masterStatusVal,
+		Status:       statusVal,
+		TaskError:    taskErrorVal,
+		TaskStatus:   taskStatusVal,
+		WorkerStatus: workerStatusVal,
+		state:        attr.ValueStateKnown,
+	}, diags
+}
+
+func NewStatusValueNull() StatusValue {
+	return StatusValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewStatusValueUnknown() StatusValue {
+	return StatusValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewStatusValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (StatusValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing StatusValue Attribute Value",
+				"While creating a StatusValue value, a missing attribute value was detected. "+
+					"A StatusValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("StatusValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid StatusValue Attribute Type",
+				"While creating a StatusValue value, an invalid attribute value was detected. "+
+					"A StatusValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("StatusValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("StatusValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra StatusValue Attribute Value",
+				"While creating a StatusValue value, an extra attribute value was detected. "+
+					"A StatusValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra StatusValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewStatusValueUnknown(), diags
+	}
+
+	lastOkAttribute, ok := attributes["last_ok"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_ok is missing from object`)
+
+		return NewStatusValueUnknown(), diags
+	}
+
+	lastOkVal, ok := lastOkAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_ok expected to be basetypes.StringValue, was: %T`, lastOkAttribute))
+	}
+
+	lastOpAttribute, ok := attributes["last_op"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_op is missing from object`)
+
+		return NewStatusValueUnknown(), diags
+	}
+
+	lastOpVal, ok := lastOpAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_op expected to be basetypes.StringValue, was: %T`, lastOpAttribute))
+	}
+
+	masterStatusAttribute, ok := attributes["master_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`master_status is missing from object`)
+
+		return NewStatusValueUnknown(), diags
+	}
+
+	masterStatusVal, ok := masterStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`master_status expected to be basetypes.StringValue, was: %T`, masterStatusAttribute))
+	}
+
+	statusAttribute, ok := attributes["status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`status is missing from object`)
+
+		return NewStatusValueUnknown(), diags
+	}
+
+	statusVal, ok := statusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
+	}
+
+	taskErrorAttribute, ok := attributes["task_error"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`task_error is missing from object`)
+
+		return NewStatusValueUnknown(), diags
+	}
+
+	taskErrorVal, ok := taskErrorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`task_error expected to be basetypes.StringValue, was: %T`, taskErrorAttribute))
+	}
+
+	taskStatusAttribute, ok := attributes["task_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`task_status is missing from object`)
+
+		return NewStatusValueUnknown(), diags
+	}
+
+	taskStatusVal, ok := taskStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`task_status expected to be basetypes.StringValue, was: %T`, taskStatusAttribute))
+	}
+
+	workerStatusAttribute, ok := attributes["worker_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`worker_status is missing from object`)
+
+		return NewStatusValueUnknown(), diags
+	}
+
+	workerStatusVal, ok := workerStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`worker_status expected to be basetypes.StringValue, was: %T`, workerStatusAttribute))
+	}
+
+	if diags.HasError() {
+		return NewStatusValueUnknown(), diags
+	}
+
+	return StatusValue{
+		LastOk:       lastOkVal,
+		LastOp:       lastOpVal,
+		MasterStatus: masterStatusVal,
+		Status:       statusVal,
+		TaskError:    taskErrorVal,
+		TaskStatus:   taskStatusVal,
+		WorkerStatus: workerStatusVal,
+		state:        attr.ValueStateKnown,
+	}, diags
+}
+
+func NewStatusValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) StatusValue {
+	object, diags := NewStatusValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewStatusValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t StatusType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewStatusValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewStatusValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewStatusValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewStatusValueMust(StatusValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t StatusType) ValueType(ctx context.Context) attr.Value {
+	return StatusValue{}
+}
+
+var _ basetypes.ObjectValuable = StatusValue{}
+
+type StatusValue struct {
+	LastOk       basetypes.StringValue `tfsdk:"last_ok"`
+	LastOp       basetypes.StringValue `tfsdk:"last_op"`
+	MasterStatus basetypes.StringValue `tfsdk:"master_status"`
+	Status       basetypes.StringValue `tfsdk:"status"`
+	TaskError    basetypes.StringValue `tfsdk:"task_error"`
+	TaskStatus   basetypes.StringValue `tfsdk:"task_status"`
+	WorkerStatus basetypes.StringValue `tfsdk:"worker_status"`
+	state        attr.ValueState
+}
+
+func (v StatusValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 7)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["last_ok"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["last_op"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["master_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["task_error"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["task_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["worker_status"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 7)
+
+		val, err = v.LastOk.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["last_ok"] = val
+
+		val, err = v.LastOp.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["last_op"] = val
+
+		val, err = v.MasterStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["master_status"] = val
+
+		val, err = v.Status.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["status"] = val
+
+		val, err = v.TaskError.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["task_error"] = val
+
+		val, err = v.TaskStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["task_status"] = val
+
+		val, err = v.WorkerStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["worker_status"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v StatusValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v StatusValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v StatusValue) String() string {
+	return "StatusValue"
+}
+
+func (v StatusValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objVal, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"last_ok":       basetypes.StringType{},
+			"last_op":       basetypes.StringType{},
+			"master_status": basetypes.StringType{},
+			"status":        basetypes.StringType{},
+			"task_error":    basetypes.StringType{},
+			"task_status":   basetypes.StringType{},
+			"worker_status": basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"last_ok":       v.LastOk,
+			"last_op":       v.LastOp,
+			"master_status": v.MasterStatus,
+			"status":        v.Status,
+			"task_error":    v.TaskError,
+			"task_status":   v.TaskStatus,
+			"worker_status": v.WorkerStatus,
+		})
+
+	return objVal, diags
+}
+
+func (v StatusValue) Equal(o attr.Value) bool {
+	other, ok := o.(StatusValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.LastOk.Equal(other.LastOk) {
+		return false
+	}
+
+	if !v.LastOp.Equal(other.LastOp) {
+		return false
+	}
+
+	if !v.MasterStatus.Equal(other.MasterStatus) {
+		return false
+	}
+
+	if !v.Status.Equal(other.Status) {
+		return false
+	}
+
+	if !v.TaskError.Equal(other.TaskError) {
+		return false
+	}
+
+	if !v.TaskStatus.Equal(other.TaskStatus) {
+		return false
+	}
+
+	if !v.WorkerStatus.Equal(other.WorkerStatus) {
+		return false
+	}
+
+	return true
+}
+
+func (v StatusValue) Type(ctx context.Context) attr.Type {
+	return StatusType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v StatusValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"last_ok":       basetypes.StringType{},
+		"last_op":       basetypes.StringType{},
+		"master_status": basetypes.StringType{},
+		"status":        basetypes.StringType{},
+		"task_error":    basetypes.StringType{},
+		"task_status":   basetypes.StringType{},
+		"worker_status": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = TimeoutsType{}
 
-	lastOkAttribute, ok := attributes["last_ok"]
+type TimeoutsType struct {
+	basetypes.ObjectType
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`last_ok is missing from object`)
+func (t TimeoutsType) Equal(o attr.Type) bool {
+	other, ok := o.(TimeoutsType)
 
-		return NewStatusValueUnknown(), diags
+	if !ok {
+		return false
 	}
 
-	lastOkVal, ok := lastOkAttribute.(basetypes.StringValue)
+	return t.ObjectType.Equal(other.ObjectType)
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`last_ok expected to be basetypes.StringValue, was: %T`, lastOkAttribute))
-	}
+func (t TimeoutsType) String() string {
+	return "TimeoutsType"
+}
 
-	lastOpAttribute, ok := attributes["last_op"]
+func (t TimeoutsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	createAttribute, ok := attributes["create"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`last_op is missing from object`)
+			`create is missing from object`)
 
-		return NewStatusValueUnknown(), diags
+		return nil, diags
 	}
 
-	lastOpVal, ok := lastOpAttribute.(basetypes.StringValue)
+	createVal, ok := createAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`last_op expected to be basetypes.StringValue, was: %T`, lastOpAttribute))
+			fmt.Sprintf(`create expected to be basetypes.StringValue, was: %T`, createAttribute))
 	}
 
-	masterStatusAttribute, ok := attributes["master_status"]
+	updateAttribute, ok := attributes["update"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`master_status is missing from object`)
+			`update is missing from object`)
 
-		return NewStatusValueUnknown(), diags
+		return nil, diags
 	}
 
-	masterStatusVal, ok := masterStatusAttribute.(basetypes.StringValue)
+	updateVal, ok := updateAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`master_status expected to be basetypes.StringValue, was: %T`, masterStatusAttribute))
+			fmt.Sprintf(`update expected to be basetypes.StringValue, was: %T`, updateAttribute))
 	}
 
-	statusAttribute, ok := attributes["status"]
+	if diags.HasError() {
+		return nil, diags
+	}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`status is missing from object`)
+	return TimeoutsValue{
+		Create: createVal,
+		Update: updateVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
 
-		return NewStatusValueUnknown(), diags
+func NewTimeoutsValueNull() TimeoutsValue {
+	return TimeoutsValue{
+		state: attr.ValueStateNull,
 	}
+}
 
-	statusVal, ok := statusAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
+func NewTimeoutsValueUnknown() TimeoutsValue {
+	return TimeoutsValue{
+		state: attr.ValueStateUnknown,
 	}
+}
 
-	taskErrorAttribute, ok := attributes["task_error"]
+func NewTimeoutsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (TimeoutsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`task_error is missing from object`)
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
 
-		return NewStatusValueUnknown(), diags
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing TimeoutsValue Attribute Value",
+				"While creating a TimeoutsValue value, a missing attribute value was detected. "+
+					"A TimeoutsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TimeoutsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid TimeoutsValue Attribute Type",
+				"While creating a TimeoutsValue value, an invalid attribute value was detected. "+
+					"A TimeoutsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TimeoutsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("TimeoutsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
 	}
 
-	taskErrorVal, ok := taskErrorAttribute.(basetypes.StringValue)
+	for name := range attributes {
+		_, ok := attributeTypes[name]
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`task_error expected to be basetypes.StringValue, was: %T`, taskErrorAttribute))
+		if !ok {
+			diags.AddError(
+				"Extra TimeoutsValue Attribute Value",
+				"While creating a TimeoutsValue value, an extra attribute value was detected. "+
+					"A TimeoutsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra TimeoutsValue Attribute Name: %s", name),
+			)
+		}
 	}
 
-	taskStatusAttribute, ok := attributes["task_status"]
+	if diags.HasError() {
+		return NewTimeoutsValueUnknown(), diags
+	}
+
+	createAttribute, ok := attributes["create"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`task_status is missing from object`)
+			`create is missing from object`)
 
-		return NewStatusValueUnknown(), diags
+		return NewTimeoutsValueUnknown(), diags
 	}
 
-	taskStatusVal, ok := taskStatusAttribute.(basetypes.StringValue)
+	createVal, ok := createAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`task_status expected to be basetypes.StringValue, was: %T`, taskStatusAttribute))
+			fmt.Sprintf(`create expected to be basetypes.StringValue, was: %T`, createAttribute))
 	}
 
-	workerStatusAttribute, ok := attributes["worker_status"]
+	updateAttribute, ok := attributes["update"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`worker_status is missing from object`)
+			`update is missing from object`)
 
-		return NewStatusValueUnknown(), diags
+		return NewTimeoutsValueUnknown(), diags
 	}
 
-	workerStatusVal, ok := workerStatusAttribute.(basetypes.StringValue)
+	updateVal, ok := updateAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`worker_status expected to be basetypes.StringValue, was: %T`, workerStatusAttribute))
+			fmt.Sprintf(`update expected to be basetypes.StringValue, was: %T`, updateAttribute))
 	}
 
 	if diags.HasError() {
-		return NewStatusValueUnknown(), diags
+		return NewTimeoutsValueUnknown(), diags
 	}
 
-	return StatusValue{
-		LastOk:       lastOkVal,
-		LastOp:       lastOpVal,
-		MasterStatus: masterStatusVal,
-		Status:       statusVal,
-		TaskError:    taskErrorVal,
-		TaskStatus:   taskStatusVal,
-		WorkerStatus: workerStatusVal,
-		state:        attr.ValueStateKnown,
+	return TimeoutsValue{
+		Create: createVal,
+		Update: updateVal,
+		state:  attr.ValueStateKnown,
 	}, diags
 }
 
-func NewStatusValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) StatusValue {
-	object, diags := NewStatusValue(attributeTypes, attributes)
+func NewTimeoutsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) TimeoutsValue {
+	object, diags := NewTimeoutsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -5926,15 +9050,15 @@ func NewStatusValueMust(attributeTypes map[string]attr.Type, attributes map[stri
 				diagnostic.Detail()))
 		}
 
-		panic("NewStatusValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewTimeoutsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t StatusType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t TimeoutsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewStatusValueNull(), nil
+		return NewTimeoutsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -5942,11 +9066,11 @@ func (t StatusType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	}
 
 	if !in.IsKnown() {
-		return NewStatusValueUnknown(), nil
+		return NewTimeoutsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewStatusValueNull(), nil
+		return NewTimeoutsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -5969,101 +9093,51 @@ func (t StatusType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 		attributes[k] = a
 	}
 
-	return NewStatusValueMust(StatusValue{}.AttributeTypes(ctx), attributes), nil
+	return NewTimeoutsValueMust(TimeoutsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t StatusType) ValueType(ctx context.Context) attr.Value {
-	return StatusValue{}
+func (t TimeoutsType) ValueType(ctx context.Context) attr.Value {
+	return TimeoutsValue{}
 }
 
-var _ basetypes.ObjectValuable = StatusValue{}
+var _ basetypes.ObjectValuable = TimeoutsValue{}
 
-type StatusValue struct {
-	LastOk       basetypes.StringValue `tfsdk:"last_ok"`
-	LastOp       basetypes.StringValue `tfsdk:"last_op"`
-	MasterStatus basetypes.StringValue `tfsdk:"master_status"`
-	Status       basetypes.StringValue `tfsdk:"status"`
-	TaskError    basetypes.StringValue `tfsdk:"task_error"`
-	TaskStatus   basetypes.StringValue `tfsdk:"task_status"`
-	WorkerStatus basetypes.StringValue `tfsdk:"worker_status"`
-	state        attr.ValueState
+type TimeoutsValue struct {
+	Create basetypes.StringValue `tfsdk:"create"`
+	Update basetypes.StringValue `tfsdk:"update"`
+	state  attr.ValueState
 }
 
-func (v StatusValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 7)
+func (v TimeoutsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["last_ok"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["last_op"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["master_status"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["status"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["task_error"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["task_status"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["worker_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["create"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["update"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 7)
-
-		val, err = v.LastOk.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["last_ok"] = val
-
-		val, err = v.LastOp.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["last_op"] = val
-
-		val, err = v.MasterStatus.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["master_status"] = val
-
-		val, err = v.Status.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["status"] = val
-
-		val, err = v.TaskError.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["task_error"] = val
+		vals := make(map[string]tftypes.Value, 2)
 
-		val, err = v.TaskStatus.ToTerraformValue(ctx)
+		val, err = v.Create.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["task_status"] = val
+		vals["create"] = val
 
-		val, err = v.WorkerStatus.ToTerraformValue(ctx)
+		val, err = v.Update.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["worker_status"] = val
+		vals["update"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -6079,46 +9153,36 @@ func (v StatusValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error
 	}
 }
 
-func (v StatusValue) IsNull() bool {
+func (v TimeoutsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v StatusValue) IsUnknown() bool {
+func (v TimeoutsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v StatusValue) String() string {
-	return "StatusValue"
+func (v TimeoutsValue) String() string {
+	return "TimeoutsValue"
 }
 
-func (v StatusValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v TimeoutsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	objVal, diags := types.ObjectValue(
 		map[string]attr.Type{
-			"last_ok":       basetypes.StringType{},
-			"last_op":       basetypes.StringType{},
-			"master_status": basetypes.StringType{},
-			"status":        basetypes.StringType{},
-			"task_error":    basetypes.StringType{},
-			"task_status":   basetypes.StringType{},
-			"worker_status": basetypes.StringType{},
+			"create": basetypes.StringType{},
+			"update": basetypes.StringType{},
 		},
 		map[string]attr.Value{
-			"last_ok":       v.LastOk,
-			"last_op":       v.LastOp,
-			"master_status": v.MasterStatus,
-			"status":        v.Status,
-			"task_error":    v.TaskError,
-			"task_status":   v.TaskStatus,
-			"worker_status": v.WorkerStatus,
+			"create": v.Create,
+			"update": v.Update,
 		})
 
 	return objVal, diags
 }
 
-func (v StatusValue) Equal(o attr.Value) bool {
-	other, ok := o.(StatusValue)
+func (v TimeoutsValue) Equal(o attr.Value) bool {
+	other, ok := o.(TimeoutsValue)
 
 	if !ok {
 		return false
@@ -6132,53 +9196,28 @@ func (v StatusValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.LastOk.Equal(other.LastOk) {
-		return false
-	}
-
-	if !v.LastOp.Equal(other.LastOp) {
-		return false
-	}
-
-	if !v.MasterStatus.Equal(other.MasterStatus) {
-		return false
-	}
-
-	if !v.Status.Equal(other.Status) {
-		return false
-	}
-
-	if !v.TaskError.Equal(other.TaskError) {
-		return false
-	}
-
-	if !v.TaskStatus.Equal(other.TaskStatus) {
+	if !v.Create.Equal(other.Create) {
 		return false
 	}
 
-	if !v.WorkerStatus.Equal(other.WorkerStatus) {
+	if !v.Update.Equal(other.Update) {
 		return false
 	}
 
 	return true
 }
 
-func (v StatusValue) Type(ctx context.Context) attr.Type {
-	return StatusType{
+func (v TimeoutsValue) Type(ctx context.Context) attr.Type {
+	return TimeoutsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v StatusValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v TimeoutsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"last_ok":       basetypes.StringType{},
-		"last_op":       basetypes.StringType{},
-		"master_status": basetypes.StringType{},
-		"status":        basetypes.StringType{},
-		"task_error":    basetypes.StringType{},
-		"task_status":   basetypes.StringType{},
-		"worker_status": basetypes.StringType{},
+		"create": basetypes.StringType{},
+		"update": basetypes.StringType{},
 	}
 }
@@ -3,9 +3,12 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net"
 	"regexp"
+	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/platform9/pf9-sdk-go/pf9/qbert"
@@ -102,6 +105,29 @@ func findLatestKubeRoleVersion(roles []qbert.Role) qbert.Role {
 	return latestRole
 }
 
+// KubeRoleVersion is a kube role version such as 1.28.6-pmk.2 split into its parts
+type KubeRoleVersion struct {
+	K8sMajorVersion int64
+	K8sMinorVersion int64
+	K8sPatchVersion int64
+	Pf9PatchVersion string
+}
+
+// parseKubeRoleVersion parses a kube role version of the form <major>.<minor>.<patch>-<pf9 patch>.
+// It is a semantic version whose prerelease is the pf9 patch, parsed like the addon versions.
+func parseKubeRoleVersion(roleVersion string) (KubeRoleVersion, error) {
+	version, err := semver.StrictNewVersion(roleVersion)
+	if err != nil {
+		return KubeRoleVersion{}, fmt.Errorf("invalid kube role version %v, expected <major>.<minor>.<patch>-<pf9 patch>: %w", roleVersion, err)
+	}
+	return KubeRoleVersion{
+		K8sMajorVersion: int64(version.Major()),
+		K8sMinorVersion: int64(version.Minor()),
+		K8sPatchVersion: int64(version.Patch()),
+		Pf9PatchVersion: version.Prerelease(),
+	}, nil
+}
+
 // CheckCIDROverlap checks if two CIDR blocks are overlapping
 func CheckCIDROverlap(cidr1, cidr2 string) (bool, error) {
 	_, network1, err := net.ParseCIDR(cidr1)
@@ -178,6 +204,15 @@ func StrSliceContains(s []string, e string) bool {
 	return false
 }
 
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func RegexMatches(regexes []string, value string) bool {
 	for _, regex := range regexes {
 		if ok, _ := regexp.Match(regex, []byte(value)); ok {
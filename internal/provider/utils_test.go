@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseKubeRoleVersion(t *testing.T) {
+	tests := []struct {
+		roleVersion string
+		want        KubeRoleVersion
+		wantErr     bool
+	}{
+		{
+			roleVersion: "1.26.14-pmk.1",
+			want:        KubeRoleVersion{K8sMajorVersion: 1, K8sMinorVersion: 26, K8sPatchVersion: 14, Pf9PatchVersion: "pmk.1"},
+		},
+		{
+			roleVersion: "1.28.2-pmk.12",
+			want:        KubeRoleVersion{K8sMajorVersion: 1, K8sMinorVersion: 28, K8sPatchVersion: 2, Pf9PatchVersion: "pmk.12"},
+		},
+		{
+			roleVersion: "1.27.6",
+			want:        KubeRoleVersion{K8sMajorVersion: 1, K8sMinorVersion: 27, K8sPatchVersion: 6},
+		},
+		{roleVersion: "1.26-pmk.1", wantErr: true},
+		{roleVersion: "v1.26.14-pmk.1", wantErr: true},
+		{roleVersion: "1.26.x-pmk.1", wantErr: true},
+		{roleVersion: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.roleVersion, func(t *testing.T) {
+			got, err := parseKubeRoleVersion(tt.roleVersion)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "invalid kube role version") {
+					t.Fatalf("parseKubeRoleVersion(%q) error = %v, want invalid kube role version", tt.roleVersion, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseKubeRoleVersion(%q) unexpected error: %v", tt.roleVersion, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseKubeRoleVersion(%q) = %+v, want %+v", tt.roleVersion, got, tt.want)
+			}
+		})
+	}
+}